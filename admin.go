@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrAdminNoToken = errors.New("admin token is required on TCP listeners")
+	ErrAdminNoTLS   = errors.New("admin TLS is required on non-loopback TCP listeners")
+	ErrNoFilename   = errors.New("empty filename")
+	ErrEmptyUpload  = errors.New("empty upload")
+	ErrUploadDigest = errors.New("upload sha256 mismatch")
+	ErrUploadSize   = errors.New("upload is too large")
+)
+
+// adminUnixPrefix - the admin address prefix of the unix socket, e.g. unix:/run/u2ckdump.sock.
+const adminUnixPrefix = "unix:"
+
+// adminServer - operational grpc service, it is served on its own listener.
+type adminServer struct {
+	pb.UnimplementedAdminServer
+
+	url, token, dir string
+	uploadMax       int64
+}
+
+// Audit - rebuilds the indexes of the current dump and compares them with the live ones.
+func (s *adminServer) Audit(ctx context.Context, in *pb.AuditRequest) (*pb.AuditResponse, error) {
+	logger.Info.Printf("Received audit, repair: %v\n", in.GetRepair())
+
+	if CurrentDump == nil || CurrentDump.utime == 0 {
+		return &pb.AuditResponse{Error: SrvDataNotReady}, nil
+	}
+
+	return CurrentDump.audit(in.GetRepair()), nil
+}
+
+// Refresh - fetches and parses the last dump in the background, even if the polling is paused.
+func (s *adminServer) Refresh(ctx context.Context, in *pb.RefreshRequest) (*pb.StatusResponse, error) {
+	logger.Info.Printf("Received refresh\n")
+
+	if !refreshWith(sourceRefresh, "", func() error { return DumpRefresh(s.url, s.token, s.dir) }) {
+		return DumpStatus.response(ErrRefreshBusy), nil
+	}
+
+	return DumpStatus.response(nil), nil
+}
+
+// Reload - parses the local dump file in the background.
+func (s *adminServer) Reload(ctx context.Context, in *pb.ReloadRequest) (*pb.StatusResponse, error) {
+	logger.Info.Printf("Received reload: %s\n", in.GetFilename())
+
+	filename := in.GetFilename()
+	if filename == "" {
+		return DumpStatus.response(ErrNoFilename), nil
+	}
+
+	if _, err := os.Stat(filename); err != nil {
+		return DumpStatus.response(err), nil
+	}
+
+	if !refreshWith(sourceReload, filename, func() error { return reloadDump(filename) }) {
+		return DumpStatus.response(ErrRefreshBusy), nil
+	}
+
+	return DumpStatus.response(nil), nil
+}
+
+// Pause - pauses or resumes the polling, the running refresh is not interrupted.
+func (s *adminServer) Pause(ctx context.Context, in *pb.PauseRequest) (*pb.StatusResponse, error) {
+	logger.Info.Printf("Received pause: %v\n", in.GetPaused())
+
+	DumpStatus.setPaused(in.GetPaused())
+
+	return DumpStatus.response(nil), nil
+}
+
+// Status - the polling state, the current dump and the last attempt.
+func (s *adminServer) Status(ctx context.Context, in *pb.StatusRequest) (*pb.StatusResponse, error) {
+	logger.Debug.Printf("Received status\n")
+
+	return DumpStatus.response(nil), nil
+}
+
+// Progress - progress of the running or the last parse.
+func (s *adminServer) Progress(ctx context.Context, in *pb.ProgressRequest) (*pb.ProgressResponse, error) {
+	logger.Debug.Printf("Received progress\n")
+
+	return ParseProgress.response(), nil
+}
+
+// Upload - stores the uploaded dump.zip in the cache dir and applies it as the fetched one.
+// The upload waits for the running refresh or reload.
+func (s *adminServer) Upload(stream pb.Admin_UploadServer) error {
+	logger.Info.Printf("Received upload\n")
+
+	resp := &pb.UploadResponse{}
+
+	tmpfilename, name, err := receiveUpload(stream, s.dir, s.uploadMax, resp)
+	if err != nil {
+		logger.Error.Printf("Upload error: %s\n", err.Error())
+		resp.Error = err.Error()
+
+		return stream.SendAndClose(resp)
+	}
+
+	defer os.Remove(tmpfilename)
+
+	refreshMutex.Lock()
+	DumpStatus.begin(sourceUpload, name)
+	err = applyUpload(tmpfilename, s.dir, name, resp.GetSha256())
+	DumpStatus.end(err)
+	refreshMutex.Unlock()
+
+	if err != nil {
+		resp.Error = err.Error()
+
+		return stream.SendAndClose(resp)
+	}
+
+	CurrentDump.RLock()
+	resp.RegistryUpdateTime = CurrentDump.utime
+	CurrentDump.RUnlock()
+
+	if summary := Summary.Load(); summary != nil {
+		resp.Summary, _ = json.Marshal(summary)
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// receiveUpload - writes the chunks to the temporary file in the cache dir and checks the digest.
+// The name is the sha256 of the archive if the client has not set it. The upload over max bytes fails, 0 is unlimited.
+func receiveUpload(stream pb.Admin_UploadServer, dir string, max int64, resp *pb.UploadResponse) (string, string, error) {
+	f, err := os.CreateTemp(dir, "upload-*.zip")
+	if err != nil {
+		return "", "", fmt.Errorf("create tmpfile: %w", err)
+	}
+
+	fail := func(err error) (string, string, error) {
+		f.Close()
+		os.Remove(f.Name())
+
+		return "", "", err
+	}
+
+	var digest, name string
+
+	hash := sha256.New()
+	w := io.MultiWriter(f, hash)
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return fail(fmt.Errorf("receive: %w", err))
+		}
+
+		if chunk.GetSha256() != "" {
+			digest = chunk.GetSha256()
+		}
+
+		if chunk.GetName() != "" {
+			name = chunk.GetName()
+		}
+
+		if max > 0 && resp.Size+int64(len(chunk.GetData())) > max {
+			return fail(fmt.Errorf("%w: over %d bytes", ErrUploadSize, max))
+		}
+
+		n, err := w.Write(chunk.GetData())
+		if err != nil {
+			return fail(fmt.Errorf("write upload: %w", err))
+		}
+
+		resp.Size += int64(n)
+	}
+
+	resp.Sha256 = hex.EncodeToString(hash.Sum(nil))
+
+	switch {
+	case resp.Size == 0:
+		return fail(ErrEmptyUpload)
+	case digest != "" && !strings.EqualFold(digest, resp.Sha256):
+		return fail(fmt.Errorf("%w: %s", ErrUploadDigest, resp.Sha256))
+	}
+
+	if err := f.Close(); err != nil {
+		return fail(fmt.Errorf("close upload: %w", err))
+	}
+
+	if name == "" {
+		name = resp.Sha256
+	}
+
+	return f.Name(), name, nil
+}
+
+// applyUpload - applies the uploaded archive and keeps it as the cached dump.zip, the caller holds refreshMutex.
+// The current dump ID is the upload one, so the poll fetches the dump again once the source is reachable.
+func applyUpload(tmpfilename, dir, name, digest string) error {
+	if err := CheckArchiveTime(tmpfilename); err != nil {
+		return err
+	}
+
+	if err := applyDumpArchive(tmpfilename, dir+"/dump.xml"); err != nil {
+		return err
+	}
+
+	DumpStatus.applied(name)
+
+	if err := os.Rename(tmpfilename, dir+"/dump.zip"); err != nil {
+		return fmt.Errorf("file rename: %w", err)
+	}
+
+	return WriteCurrentDumpID(dir+"/current", &DumpAnswer{ID: name, CRC: digest})
+}
+
+// listenAdmin - TCP address or unix socket with the "unix:" prefix, the socket is owner only.
+func listenAdmin(addr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, adminUnixPrefix)
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+
+	listen, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		listen.Close()
+
+		return nil, fmt.Errorf("chmod socket: %w", err)
+	}
+
+	return listen, nil
+}
+
+// isLoopback - the TCP listen address is the loopback one, the empty host is any address.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// newAdminServer - the admin grpc server with its own credentials: the bearer token and the optional TLS.
+// The token may be empty on the unix socket only, TLS is optional on the unix socket and loopback addresses only,
+// the token is not sent in plaintext over the network.
+func newAdminServer(addr, token, certFile, keyFile string) (*grpc.Server, error) {
+	var opts []grpc.ServerOption
+
+	unix := strings.HasPrefix(addr, adminUnixPrefix)
+
+	if token == "" && !unix {
+		return nil, ErrAdminNoToken
+	}
+
+	if certFile == "" && keyFile == "" && !unix && !isLoopback(addr) {
+		return nil, ErrAdminNoTLS
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load admin certificate: %w", err)
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})))
+	}
+
+	if token != "" {
+		auth := adminAuth{token: token}
+		opts = append(opts, grpc.UnaryInterceptor(auth.unary), grpc.StreamInterceptor(auth.stream))
+	}
+
+	return grpc.NewServer(opts...), nil
+}
+
+// adminAuth - checks the authorization: Bearer <token> metadata of the admin calls.
+type adminAuth struct {
+	token string
+}
+
+func (a adminAuth) check(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(value), []byte("Bearer "+a.token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "bad admin token")
+}
+
+func (a adminAuth) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.check(ctx); err != nil {
+		logger.Warning.Printf("Admin %s: %s\n", info.FullMethod, err.Error())
+
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a adminAuth) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.check(ss.Context()); err != nil {
+		logger.Warning.Printf("Admin %s: %s\n", info.FullMethod, err.Error())
+
+		return err
+	}
+
+	return handler(srv, ss)
+}
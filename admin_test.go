@@ -0,0 +1,258 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestAdminReload(t *testing.T) {
+	CurrentDump = NewDump()
+	DumpStatus = &dumpStatus{}
+
+	filename := filepath.Join(t.TempDir(), "dump.xml")
+	if err := os.WriteFile(filename, []byte(xml01), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &adminServer{}
+
+	// the refresh is running.
+	refreshMutex.Lock()
+
+	if resp, _ := s.Reload(context.Background(), &pb.ReloadRequest{Filename: filename}); resp.GetError() != ErrRefreshBusy.Error() {
+		t.Errorf("Expected %q, got %q", ErrRefreshBusy.Error(), resp.GetError())
+	}
+
+	refreshMutex.Unlock()
+
+	if resp, _ := s.Reload(context.Background(), &pb.ReloadRequest{Filename: filename + ".missing"}); resp.GetError() == "" {
+		t.Errorf("Expected error, got none")
+	}
+
+	if resp, _ := s.Reload(context.Background(), &pb.ReloadRequest{Filename: filename}); resp.GetError() != "" || !resp.GetBusy() {
+		t.Fatalf("Expected busy, got %v", resp)
+	}
+
+	// wait for the reload.
+	refreshMutex.Lock()
+	refreshMutex.Unlock()
+
+	resp, _ := s.Status(context.Background(), &pb.StatusRequest{})
+	if resp.GetBusy() || resp.GetCurrentID() != filename || resp.GetAttemptSource() != sourceReload || resp.GetAttemptError() != "" {
+		t.Errorf("Expected reloaded %s, got %v", filename, resp)
+	}
+
+	if _, ok := CurrentDump.ContentIndex[444]; !ok {
+		t.Errorf("Expected reloaded records")
+	}
+
+	progress, _ := s.Progress(context.Background(), &pb.ProgressRequest{})
+	if progress.GetRunning() || progress.GetBytesRead() != int64(len(xml01)) || progress.GetBytesTotal() != int64(len(xml01)) ||
+		progress.GetRecords() != int32(len(CurrentDump.ContentIndex)) {
+		t.Errorf("Expected finished progress of %d bytes, got %v", len(xml01), progress)
+	}
+
+	if resp, _ := s.Pause(context.Background(), &pb.PauseRequest{Paused: true}); !resp.GetPaused() || !DumpStatus.Paused() {
+		t.Errorf("Expected paused, got %v", resp)
+	}
+
+	if resp, _ := s.Pause(context.Background(), &pb.PauseRequest{}); resp.GetPaused() {
+		t.Errorf("Expected resumed, got %v", resp)
+	}
+}
+
+func TestAdminAuth(t *testing.T) {
+	auth := adminAuth{token: "secret"}
+
+	tests := []struct {
+		md   metadata.MD
+		code codes.Code
+	}{
+		{metadata.Pairs("authorization", "Bearer secret"), codes.OK},
+		{metadata.Pairs("authorization", "Bearer wrong"), codes.Unauthenticated},
+		{metadata.Pairs("authorization", "secret"), codes.Unauthenticated},
+		{nil, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		if err := auth.check(metadata.NewIncomingContext(context.Background(), tt.md)); status.Code(err) != tt.code {
+			t.Errorf("%v: Expected %v, got %v", tt.md, tt.code, status.Code(err))
+		}
+	}
+
+	if _, err := newAdminServer("127.0.0.1:0", "", "", ""); err != ErrAdminNoToken {
+		t.Errorf("Expected %v, got %v", ErrAdminNoToken, err)
+	}
+
+	if _, err := newAdminServer("0.0.0.0:50002", "token", "", ""); err != ErrAdminNoTLS {
+		t.Errorf("Expected %v, got %v", ErrAdminNoTLS, err)
+	}
+
+	if _, err := newAdminServer("[::1]:50002", "token", "", ""); err != nil {
+		t.Errorf("Expected no TLS on loopback, got %v", err)
+	}
+
+	if _, err := newAdminServer(adminUnixPrefix+"u2ckdump.sock", "", "", ""); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+// zipDump - the archive with the dump file as it comes from the source.
+func zipDump(t *testing.T, name, dump string) []byte {
+	var buf bytes.Buffer
+
+	w := zip.NewWriter(&buf)
+
+	f, err := w.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Write([]byte(dump))
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestAdminUpload(t *testing.T) {
+	CurrentDump = NewDump()
+	DumpStatus = &dumpStatus{}
+
+	dir := t.TempDir()
+
+	listen := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterAdminServer(srv, &adminServer{dir: dir, uploadMax: 1 << 16})
+
+	go srv.Serve(listen)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listen.DialContext(ctx) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewAdminClient(conn)
+
+	upload := func(archive []byte, digest string) *pb.UploadResponse {
+		stream, err := client.Upload(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < len(archive); i += 100 {
+			chunk := &pb.UploadChunk{Data: archive[i:min(i+100, len(archive))]}
+			if i == 0 {
+				chunk.Sha256, chunk.Name = digest, "dump-1"
+			}
+
+			// The server fails the upload before the last chunk on the size limit.
+			if err := stream.Send(chunk); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resp
+	}
+
+	archive := zipDump(t, "dump.xml", xml01)
+	sum := sha256.Sum256(archive)
+	digest := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		archive []byte
+		digest  string
+		err     error
+	}{
+		{archive, "00" + digest[2:], ErrUploadDigest},
+		{zipDump(t, "other.xml", xml01), "", ErrNoDumpInArchive},
+		{zipDump(t, "dump.xml", "<html></html>"), "", ErrBadDumpFile},
+		{zipDump(t, "dump.xml", xml01[:len(xml01)/2]), "", ErrBadDumpFile},
+		{make([]byte, 1<<16+1), "", ErrUploadSize},
+		{nil, "", ErrEmptyUpload},
+	}
+
+	for _, tt := range tests {
+		if resp := upload(tt.archive, tt.digest); !strings.Contains(resp.GetError(), tt.err.Error()) {
+			t.Errorf("Expected %q, got %q", tt.err.Error(), resp.GetError())
+		}
+	}
+
+	if len(CurrentDump.ContentIndex) != 0 {
+		t.Fatalf("Expected no records after the failed uploads, got %d", len(CurrentDump.ContentIndex))
+	}
+
+	resp := upload(archive, digest)
+	if resp.GetError() != "" || resp.GetSha256() != digest || resp.GetSize() != int64(len(archive)) || len(resp.GetSummary()) == 0 {
+		t.Fatalf("Expected applied upload, got %v", resp)
+	}
+
+	if _, ok := CurrentDump.ContentIndex[444]; !ok {
+		t.Errorf("Expected uploaded records")
+	}
+
+	if b, err := os.ReadFile(filepath.Join(dir, "dump.zip")); err != nil || !bytes.Equal(b, archive) {
+		t.Errorf("Expected the cached archive, got %v", err)
+	}
+
+	if current, _ := ReadCurrentDumpID(filepath.Join(dir, "current")); current.ID != "dump-1" || current.CRC != digest {
+		t.Errorf("Expected current dump-1, got %v", current)
+	}
+
+	if leftovers, _ := filepath.Glob(filepath.Join(dir, "upload-*")); len(leftovers) != 0 {
+		t.Errorf("Expected no temporary uploads, got %v", leftovers)
+	}
+
+	defer func(shrink float64) { MaxDumpShrink = shrink }(MaxDumpShrink)
+
+	MaxDumpShrink = 0.1
+
+	refused := []struct {
+		archive []byte
+		err     error
+	}{
+		{zipDump(t, "dump.xml", dumpWithout("2010-01-01T01:01:01+03:00", "555")), ErrArchiveOlder},
+		{zipDump(t, "dump.xml", dumpWithout("2011-01-02T01:01:01+03:00", "555")), ErrDumpShrunk},
+	}
+
+	for _, tt := range refused {
+		if resp := upload(tt.archive, ""); !strings.Contains(resp.GetError(), tt.err.Error()) {
+			t.Errorf("Expected %q, got %q", tt.err.Error(), resp.GetError())
+		}
+	}
+
+	if _, ok := CurrentDump.ContentIndex[555]; !ok {
+		t.Errorf("Expected the records kept after the refused uploads")
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// aggregatePrefixes - minimal list of prefixes covering the same addresses.
+// Prefixes of the one address family are expected.
+func aggregatePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	list := make([]netip.Prefix, 0, len(prefixes))
+	for _, p := range prefixes {
+		if p.IsValid() {
+			list = append(list, p.Masked())
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if c := list[i].Addr().Compare(list[j].Addr()); c != 0 {
+			return c < 0
+		}
+
+		return list[i].Bits() < list[j].Bits()
+	})
+
+	res := make([]netip.Prefix, 0, len(list))
+
+	for _, p := range list {
+		// covered by the previous one, shorter prefixes go first.
+		if n := len(res); n > 0 && res[n-1].Overlaps(p) {
+			continue
+		}
+
+		res = append(res, p)
+
+		// merge siblings into the parent while possible.
+		for n := len(res); n > 1; n = len(res) {
+			parent, ok := siblingsParent(res[n-2], res[n-1])
+			if !ok {
+				break
+			}
+
+			res = append(res[:n-2], parent)
+		}
+	}
+
+	return res
+}
+
+// siblingsParent - the parent prefix if a and b are its two halves.
+func siblingsParent(a, b netip.Prefix) (netip.Prefix, bool) {
+	if a.Bits() != b.Bits() || a.Bits() == 0 {
+		return netip.Prefix{}, false
+	}
+
+	parent := netip.PrefixFrom(a.Addr(), a.Bits()-1).Masked()
+	if parent.Addr() != a.Addr() || !parent.Contains(b.Addr()) || a.Addr() == b.Addr() {
+		return netip.Prefix{}, false
+	}
+
+	return parent, true
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestAggregatePrefixes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"single", "10.0.0.1/32", "10.0.0.1/32"},
+		{"siblings", "10.0.0.1/32 10.0.0.0/32", "10.0.0.0/31"},
+		{"cascade", "10.0.0.0/32 10.0.0.1/32 10.0.0.2/31 10.0.0.4/30", "10.0.0.0/29"},
+		{"not siblings", "10.0.0.1/32 10.0.0.2/32", "10.0.0.1/32 10.0.0.2/32"},
+		{"covered", "10.4.4.4/32 10.4.0.0/16 10.4.0.0/24", "10.4.0.0/16"},
+		{"unmasked", "10.4.1.1/16 10.5.0.0/16", "10.4.0.0/15"},
+		{"duplicates", "10.0.0.1/32 10.0.0.1/32", "10.0.0.1/32"},
+		{"ipv6", "fd00::/65 fd00:0:0:0:8000::/65 fd00::1/128", "fd00::/64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var in []netip.Prefix
+			for _, s := range strings.Fields(tt.in) {
+				in = append(in, netip.MustParsePrefix(s))
+			}
+
+			var got []string
+			for _, p := range aggregatePrefixes(in) {
+				got = append(got, p.String())
+			}
+
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, strings.Join(got, " "))
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// auditMaxFindings - findings in the response, the rest are counted only.
+const auditMaxFindings = 1000
+
+// Errors
+var (
+	ErrAuditParsing = errors.New("dump is being parsed, indexes are not final")
+)
+
+// Audited indexes, the tombstone ones are prefixed with auditTombstonePrefix.
+const (
+	auditIPv4Index         = "ipv4"
+	auditIPv6Index         = "ipv6"
+	auditSubnetIPv4Index   = "subnet_ipv4"
+	auditSubnetIPv6Index   = "subnet_ipv6"
+	auditURLIndex          = "url"
+	auditDomainIndex       = "domain"
+	auditPublicSuffixIndex = "public_suffix"
+	auditDecisionIndex     = "decision"
+	auditOrgIndex          = "org"
+	auditEntryTypeIndex    = "entry_type"
+	auditWithoutNoIndex    = "without_decision_no"
+	auditNetTree           = "net_tree"
+	auditPackedOrgIndex    = "packed_org"
+	auditIncludeTimeIndex  = "include_time"
+	auditRecordTimeIndex   = "record_time"
+	auditElementTimeIndex  = "element_time"
+	auditTombstonePrefix   = "tombstone_"
+)
+
+// auditReport - collects the findings of the audit.
+type auditReport struct {
+	resp *pb.AuditResponse
+}
+
+func (report *auditReport) add(index, key string, id int32, problem pb.AuditProblem) {
+	report.resp.Total++
+	report.resp.Counts[index]++
+
+	if len(report.resp.Findings) < auditMaxFindings {
+		report.resp.Findings = append(report.resp.Findings, &pb.AuditFinding{Index: index, Key: key, Id: id, Problem: problem})
+	}
+}
+
+// auditPostings - compares the live postings with the rebuilt ones key by key.
+// Lists are compared as sets, org and entry type lists are long.
+func auditPostings[K comparable](report *auditReport, index string, live, rebuilt map[K]IntArrayStorage, format func(K) string) {
+	for key, ids := range live {
+		if len(ids) == 0 {
+			report.add(index, format(key), 0, pb.AuditProblem_AUDIT_ORPHANED)
+		}
+
+		rebuiltIDs := postingSet(rebuilt[key])
+
+		for _, id := range ids {
+			if _, ok := rebuiltIDs[id]; !ok {
+				report.add(index, format(key), id, pb.AuditProblem_AUDIT_ORPHANED)
+			}
+		}
+	}
+
+	for key, ids := range rebuilt {
+		liveIDs := postingSet(live[key])
+
+		for _, id := range ids {
+			if _, ok := liveIDs[id]; !ok {
+				report.add(index, format(key), id, pb.AuditProblem_AUDIT_MISSING)
+			}
+		}
+	}
+}
+
+func postingSet(ids IntArrayStorage) Int32Map {
+	set := make(Int32Map, len(ids))
+	for _, id := range ids {
+		set[id] = Nothing{}
+	}
+
+	return set
+}
+
+// auditTimes - compares the live time index with the rebuilt one, entries are counted.
+func auditTimes(report *auditReport, index string, live, rebuilt TimeSearchIndex) {
+	counts := make(map[timeEntry]int, len(rebuilt))
+	for _, entry := range rebuilt {
+		counts[entry]++
+	}
+
+	for _, entry := range live {
+		if counts[entry] == 0 {
+			report.add(index, timeEntryKey(entry), entry.ID, pb.AuditProblem_AUDIT_ORPHANED)
+
+			continue
+		}
+
+		counts[entry]--
+	}
+
+	for entry, n := range counts {
+		for ; n > 0; n-- {
+			report.add(index, timeEntryKey(entry), entry.ID, pb.AuditProblem_AUDIT_MISSING)
+		}
+	}
+}
+
+func timeEntryKey(entry timeEntry) string {
+	if entry.Key == "" {
+		return strconv.FormatInt(entry.Ts, 10)
+	}
+
+	return strconv.FormatInt(entry.Ts, 10) + " " + entry.Key
+}
+
+// auditPackedOrgs - compares the org hashes of the live dump with the rebuilt ones.
+func auditPackedOrgs(report *auditReport, live, rebuilt map[uint64]string) {
+	for hash, org := range live {
+		if rebuilt[hash] != org {
+			report.add(auditPackedOrgIndex, org, 0, pb.AuditProblem_AUDIT_ORPHANED)
+		}
+	}
+
+	for hash, org := range rebuilt {
+		if live[hash] != org {
+			report.add(auditPackedOrgIndex, org, 0, pb.AuditProblem_AUDIT_MISSING)
+		}
+	}
+}
+
+// postingList - the list as the index with the only empty key, the empty list is the empty index.
+func postingList(ids IntArrayStorage) map[string]IntArrayStorage {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return map[string]IntArrayStorage{"": ids}
+}
+
+// auditNetworks - compares the networks of the live radix tree with the rebuilt one.
+func auditNetworks(report *auditReport, index string, live, rebuilt *Dump) error {
+	liveNetworks, err := live.networks()
+	if err != nil {
+		return err
+	}
+
+	rebuiltNetworks, err := rebuilt.networks()
+	if err != nil {
+		return err
+	}
+
+	for network := range liveNetworks {
+		if _, ok := rebuiltNetworks[network]; !ok {
+			report.add(index, network, 0, pb.AuditProblem_AUDIT_ORPHANED)
+		}
+	}
+
+	for network := range rebuiltNetworks {
+		if _, ok := liveNetworks[network]; !ok {
+			report.add(index, network, 0, pb.AuditProblem_AUDIT_MISSING)
+		}
+	}
+
+	return nil
+}
+
+// networks - all networks of the radix tree.
+func (dump *Dump) networks() (StringMap, error) {
+	networks := make(StringMap)
+
+	for _, all := range []string{"0.0.0.0/0", "::/0"} {
+		_, network, _ := net.ParseCIDR(all)
+
+		entries, err := dump.netTree.CoveredNetworks(*network)
+		if err != nil {
+			return nil, fmt.Errorf("covered networks: %w", err)
+		}
+
+		for _, entry := range entries {
+			network := entry.Network()
+			networks[network.String()] = Nothing{}
+		}
+	}
+
+	return networks, nil
+}
+
+// audit - rebuilds the indexes from ContentIndex and compares them with the live ones: the postings, the radix tree,
+// the packed orgs, the time indexes and the same indexes of the tombstones.
+// The live indexes are replaced with the rebuilt ones on repair if they differ.
+// The running parse is not audited, the packed orgs and the time indexes are built by Cleanup.
+func (dump *Dump) audit(repair bool) *pb.AuditResponse {
+	if repair {
+		dump.Lock()
+		defer dump.Unlock()
+	} else {
+		dump.RLock()
+		defer dump.RUnlock()
+	}
+
+	start := time.Now()
+	report := &auditReport{resp: &pb.AuditResponse{RegistryUpdateTime: dump.utime, Counts: make(map[string]int32)}}
+
+	if dump.parsing {
+		report.resp.Error = ErrAuditParsing.Error()
+
+		return report.resp
+	}
+
+	rebuilt := dump.rebuildIndexes()
+	err := auditIndexes(report, "", dump, rebuilt)
+
+	auditPackedOrgs(report, dump.packedOrgIndex, rebuilt.packedOrgIndex)
+
+	var rebuiltTombstones *Dump
+
+	if dump.tombstones != nil && err == nil {
+		rebuiltTombstones = dump.tombstones.rebuildIndexes()
+		err = auditIndexes(report, auditTombstonePrefix, dump.tombstones.Dump, rebuiltTombstones)
+	}
+
+	if err != nil {
+		report.resp.Error = err.Error()
+	}
+
+	if repair && report.resp.Total > 0 && report.resp.Error == "" {
+		dump.adoptIndexes(rebuilt)
+
+		if rebuiltTombstones != nil {
+			dump.tombstones.adoptIndexes(rebuiltTombstones)
+		}
+
+		report.resp.Repaired = true
+	}
+
+	report.resp.Duration = time.Since(start).Milliseconds()
+
+	return report.resp
+}
+
+// rebuildIndexes - the indexes built from the records from scratch, ContentIndex is shared.
+func (dump *Dump) rebuildIndexes() *Dump {
+	rebuilt := newDump()
+	for _, cont := range dump.ContentIndex {
+		rebuilt.insertToIndexes(cont)
+	}
+
+	rebuilt.ContentIndex = dump.ContentIndex
+	rebuilt.buildTimeIndexes()
+	rebuilt.buildPackedOrgIndex()
+
+	return rebuilt
+}
+
+// auditIndexes - compares the postings, the time indexes and the radix tree, prefix is added to the index names.
+func auditIndexes(report *auditReport, prefix string, dump, rebuilt *Dump) error {
+	uint32Key := func(key uint32) string { return int2Ip4(key) }
+	uint64Key := func(key uint64) string { return strconv.FormatUint(key, 10) }
+	stringKey := func(key string) string { return key }
+	ip6Key := func(key string) string { return net.IP(key).String() }
+
+	auditPostings(report, prefix+auditIPv4Index, dump.IPv4Index, rebuilt.IPv4Index, uint32Key)
+	auditPostings(report, prefix+auditIPv6Index, dump.IPv6Index, rebuilt.IPv6Index, ip6Key)
+	auditPostings(report, prefix+auditSubnetIPv4Index, dump.subnetIPv4Index, rebuilt.subnetIPv4Index, stringKey)
+	auditPostings(report, prefix+auditSubnetIPv6Index, dump.subnetIPv6Index, rebuilt.subnetIPv6Index, stringKey)
+	auditPostings(report, prefix+auditURLIndex, dump.URLIndex, rebuilt.URLIndex, stringKey)
+	auditPostings(report, prefix+auditDomainIndex, dump.domainIndex, rebuilt.domainIndex, stringKey)
+	auditPostings(report, prefix+auditPublicSuffixIndex, dump.publicSuffixIndex, rebuilt.publicSuffixIndex, stringKey)
+	auditPostings(report, prefix+auditDecisionIndex, dump.decisionIndex, rebuilt.decisionIndex, uint64Key)
+	auditPostings(report, prefix+auditOrgIndex, dump.orgIndex, rebuilt.orgIndex, stringKey)
+	auditPostings(report, prefix+auditEntryTypeIndex, dump.entryTypeIndex, rebuilt.entryTypeIndex, stringKey)
+	auditPostings(report, prefix+auditWithoutNoIndex, postingList(dump.withoutDecisionNo), postingList(rebuilt.withoutDecisionNo), stringKey)
+
+	auditTimes(report, prefix+auditIncludeTimeIndex, dump.includeTimeIndex, rebuilt.includeTimeIndex)
+	auditTimes(report, prefix+auditRecordTimeIndex, dump.recordTimeIndex, rebuilt.recordTimeIndex)
+	auditTimes(report, prefix+auditElementTimeIndex, dump.elementTimeIndex, rebuilt.elementTimeIndex)
+
+	return auditNetworks(report, prefix+auditNetTree, dump, rebuilt)
+}
+
+// adoptIndexes - replaces the live indexes with the rebuilt ones, page tokens are invalidated.
+func (dump *Dump) adoptIndexes(rebuilt *Dump) {
+	dump.IPv4Index, dump.IPv6Index = rebuilt.IPv4Index, rebuilt.IPv6Index
+	dump.subnetIPv4Index, dump.subnetIPv6Index, dump.netTree = rebuilt.subnetIPv4Index, rebuilt.subnetIPv6Index, rebuilt.netTree
+	dump.URLIndex, dump.domainIndex, dump.publicSuffixIndex = rebuilt.URLIndex, rebuilt.domainIndex, rebuilt.publicSuffixIndex
+	dump.decisionIndex, dump.orgIndex, dump.entryTypeIndex = rebuilt.decisionIndex, rebuilt.orgIndex, rebuilt.entryTypeIndex
+	dump.withoutDecisionNo = rebuilt.withoutDecisionNo
+	dump.includeTimeIndex, dump.recordTimeIndex, dump.elementTimeIndex = rebuilt.includeTimeIndex, rebuilt.recordTimeIndex, rebuilt.elementTimeIndex
+
+	dump.buildPackedOrgIndex()
+	dump.generation++
+}
+
+// AuditPoll - audits the current dump periodically.
+func AuditPoll(kill <-chan struct{}, d time.Duration, repair bool) {
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if CurrentDump.utime == 0 {
+				continue
+			}
+
+			resp := CurrentDump.audit(repair)
+
+			switch {
+			case resp.GetError() == ErrAuditParsing.Error():
+				logger.Debug.Printf("Index audit skipped: %s\n", resp.GetError())
+			case resp.GetError() != "":
+				logger.Error.Printf("Index audit error: %s\n", resp.GetError())
+			case resp.GetTotal() > 0:
+				logger.Warning.Printf("Index audit: %d findings %v, repaired: %v\n", resp.GetTotal(), resp.GetCounts(), resp.GetRepaired())
+			default:
+				logger.Debug.Printf("Index audit: no findings, %d ms\n", resp.GetDuration())
+			}
+		case <-kill:
+			return
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestAudit(t *testing.T) {
+	CurrentDump = NewDump()
+
+	dump := strings.Replace(xml01, `<ipSubnet>10.4.0.0/16</ipSubnet>`, `<ipSubnet>10.4.0.0/16</ipSubnet>
+        <ipv6Subnet>fd44::/32</ipv6Subnet>`, 1)
+	dump = strings.Replace(dump, `<domain><![CDATA[www.e01.tld]]></domain>`, `<domain><![CDATA[www.e01.tld]]></domain>
+        <domain><![CDATA[cdn.e01.tld]]></domain>`, 1)
+
+	if err := Parse(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp := CurrentDump.audit(false); resp.GetTotal() != 0 {
+		t.Fatalf("Expected no findings, got %v", resp.GetFindings())
+	}
+
+	// the subnet moves, the domain changes its spelling, one of the domains with the shared parent is removed.
+	dump = strings.Replace(dump, `<ipv6Subnet>fd44::/32</ipv6Subnet>`, `<ipv6Subnet>fd45::/32</ipv6Subnet>`, 1)
+	dump = strings.Replace(dump, `<domain><![CDATA[www.e01.tld]]></domain>
+        <domain><![CDATA[cdn.e01.tld]]></domain>`, `<domain><![CDATA[WWW.e01.tld]]></domain>`, 1)
+
+	if err := Parse(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp := CurrentDump.audit(false); resp.GetTotal() != 0 {
+		t.Fatalf("Expected no findings, got %v", resp.GetFindings())
+	}
+
+	CurrentDump.IPv4Index.Insert(IPv4StrToInt("10.9.9.9"), 999)
+	CurrentDump.domainIndex.Remove("www.e01.tld", 111)
+	CurrentDump.RemoveFromSubnetIPv6Index("fd45::/32", 444)
+
+	resp := CurrentDump.audit(false)
+	if resp.GetTotal() != 4 || resp.GetRepaired() {
+		t.Fatalf("Expected 4 findings, got %v", resp.GetFindings())
+	}
+
+	for _, want := range []*pb.AuditFinding{
+		{Index: auditIPv4Index, Key: "10.9.9.9", Id: 999, Problem: pb.AuditProblem_AUDIT_ORPHANED},
+		{Index: auditDomainIndex, Key: "www.e01.tld", Id: 111, Problem: pb.AuditProblem_AUDIT_MISSING},
+		{Index: auditSubnetIPv6Index, Key: "fd45::/32", Id: 444, Problem: pb.AuditProblem_AUDIT_MISSING},
+		{Index: auditNetTree, Key: "fd45::/32", Problem: pb.AuditProblem_AUDIT_MISSING},
+	} {
+		found := false
+		for _, finding := range resp.GetFindings() {
+			if finding.GetIndex() == want.GetIndex() && finding.GetKey() == want.GetKey() &&
+				finding.GetId() == want.GetId() && finding.GetProblem() == want.GetProblem() {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("Expected %v in %v", want, resp.GetFindings())
+		}
+	}
+
+	if resp := CurrentDump.audit(true); !resp.GetRepaired() {
+		t.Errorf("Expected repaired, got %v", resp)
+	}
+
+	if resp := CurrentDump.audit(false); resp.GetTotal() != 0 {
+		t.Errorf("Expected no findings after repair, got %v", resp.GetFindings())
+	}
+
+	if matches := CurrentDump.searchIPv6(net.ParseIP("fd45::1")); len(matches) != 1 {
+		t.Errorf("Expected the repaired subnet to match, got %v", matches)
+	}
+
+	// the time indexes and the tombstones are audited too.
+	if err := Parse(strings.NewReader(dumpWithout("2011-01-02T01:01:01+03:00", "555"))); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp := CurrentDump.audit(false); resp.GetTotal() != 0 {
+		t.Fatalf("Expected no findings, got %v", resp.GetFindings())
+	}
+
+	CurrentDump.includeTimeIndex = CurrentDump.includeTimeIndex[1:]
+	CurrentDump.tombstones.IPv4Index.Insert(IPv4StrToInt("10.8.8.8"), 555)
+
+	resp = CurrentDump.audit(true)
+	if resp.GetCounts()[auditIncludeTimeIndex] != 1 || resp.GetCounts()[auditTombstonePrefix+auditIPv4Index] != 1 || !resp.GetRepaired() {
+		t.Errorf("Expected repaired time and tombstone findings, got %v", resp.GetCounts())
+	}
+
+	if resp := CurrentDump.audit(false); resp.GetTotal() != 0 {
+		t.Errorf("Expected no findings after repair, got %v", resp.GetFindings())
+	}
+
+	CurrentDump.beginParse()
+
+	if resp := CurrentDump.audit(false); resp.GetError() != ErrAuditParsing.Error() {
+		t.Errorf("Expected %v, got %q", ErrAuditParsing, resp.GetError())
+	}
+
+	CurrentDump.endParse()
+}
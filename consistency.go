@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/normalize"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrUnknownCheck = errors.New("unknown consistency check")
+)
+
+// findingIndex - findings of the parsed records by content ID: duplicate elements, unchanged hashes and block types.
+type findingIndex map[int32][]*pb.Finding
+
+// set - replaces the findings of the record, the record without them is dropped.
+func (index findingIndex) set(id int32, findings []*pb.Finding) {
+	if len(findings) == 0 {
+		delete(index, id)
+
+		return
+	}
+
+	index[id] = findings
+}
+
+func newFinding(id int32, check pb.ConsistencyCheck, detail string) *pb.Finding {
+	return &pb.Finding{Id: id, Check: check, Detail: detail}
+}
+
+// recordFindings - checks of the parsed record against itself and the previous version, prev is nil for new records.
+// Elements are compared by index keys. It is called after the extraction, HTTPSBlock is counted there.
+func recordFindings(record *Content, prev *PackedContent) []*pb.Finding {
+	var findings []*pb.Finding
+
+	seen := make(StringMap)
+	check := func(element, key string) {
+		key = element + " " + key
+		if _, ok := seen[key]; ok {
+			findings = append(findings, newFinding(record.ID, pb.ConsistencyCheck_CHECK_DUPLICATE_ELEMENT, key))
+
+			return
+		}
+
+		seen[key] = Nothing{}
+	}
+
+	for _, ip4 := range record.IPv4 {
+		check(elementIP4, int2Ip4(ip4.IPv4))
+	}
+
+	for _, ip6 := range record.IPv6 {
+		check(elementIP6, net.IP(ip6.IPv6).String())
+	}
+
+	for _, subnet4 := range record.SubnetIPv4 {
+		check(elementIP4Subnet, subnet4.SubnetIPv4)
+	}
+
+	for _, subnet6 := range record.SubnetIPv6 {
+		check(elementIP6Subnet, subnet6.SubnetIPv6)
+	}
+
+	for _, domain := range record.Domain {
+		check(elementDomain, normalize.Domain(domain.Domain))
+	}
+
+	for _, u := range record.URL {
+		check(elementURL, normalize.URL(u.URL))
+	}
+
+	// only changed records are merged.
+	if prev != nil && record.Hash != "" && record.Hash == prev.Record().GetHash() {
+		findings = append(findings, newFinding(record.ID, pb.ConsistencyCheck_CHECK_HASH_UNCHANGED, record.Hash))
+	}
+
+	switch blockType := record.constructBlockType(); blockType {
+	case BlockTypeDomain, BlockTypeMask:
+		if len(record.Domain) == 0 {
+			findings = append(findings, newFinding(record.ID, pb.ConsistencyCheck_CHECK_DOMAIN_WITHOUT_DOMAIN, blockTypeName(blockType)))
+		}
+
+		if blockType != BlockTypeMask {
+			break
+		}
+
+		for _, domain := range record.Domain {
+			if !strings.HasPrefix(domain.Domain, "*.") {
+				findings = append(findings, newFinding(record.ID, pb.ConsistencyCheck_CHECK_MASK_WITHOUT_WILDCARD, domain.Domain))
+			}
+		}
+	case BlockTypeIP:
+		if len(record.IPv4)+len(record.IPv6)+len(record.SubnetIPv4)+len(record.SubnetIPv6) == 0 {
+			findings = append(findings, newFinding(record.ID, pb.ConsistencyCheck_CHECK_IP_WITHOUT_ADDRESS, blockTypeName(blockType)))
+		}
+	}
+
+	return findings
+}
+
+// refreshConsistency - builds the report under the read lock after Cleanup, Check readers are not blocked by the scan.
+func (dump *Dump) refreshConsistency() {
+	dump.RLock()
+	findings := dump.checkConsistency()
+	dump.RUnlock()
+
+	dump.Lock()
+	dump.consistency = findings
+	dump.Unlock()
+}
+
+// checkConsistency - findings of the parsed records and the URL host checks of all records.
+// URL hosts are looked up in the domain index and in the domain-mask records.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) checkConsistency() []*pb.Finding {
+	var findings []*pb.Finding
+
+	for id, pack := range dump.ContentIndex {
+		findings = append(findings, dump.findings[id]...)
+
+		switch pack.BlockType {
+		case BlockTypeURL, BlockTypeHTTPS:
+			hosts := make(StringMap, len(pack.URL))
+
+			for _, u := range pack.URL {
+				nurl, err := url.Parse(normalize.URL(u.URL))
+				if err != nil {
+					continue
+				}
+
+				host := nurl.Hostname()
+				if _, ok := hosts[host]; ok || host == "" || net.ParseIP(host) != nil {
+					continue
+				}
+
+				hosts[host] = Nothing{}
+
+				if len(dump.domainIndex[host]) == 0 && len(dump.searchDomainMask(host)) == 0 {
+					findings = append(findings, newFinding(id, pb.ConsistencyCheck_CHECK_URL_HOST_NOT_INDEXED, host))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].GetId() != findings[j].GetId() {
+			return findings[i].GetId() < findings[j].GetId()
+		}
+
+		return findings[i].GetCheck() < findings[j].GetCheck()
+	})
+
+	return findings
+}
+
+// consistencyReport - the report filtered by checks and content ID, expects the dump to be read locked by the caller.
+func (dump *Dump) consistencyReport(checks []pb.ConsistencyCheck, id int32) *pb.ConsistencyResponse {
+	resp := &pb.ConsistencyResponse{RegistryUpdateTime: dump.utime, Counts: make(map[string]int32)}
+
+	for _, finding := range dump.consistency {
+		resp.Counts[finding.GetCheck().String()]++
+
+		if id != 0 && finding.GetId() != id {
+			continue
+		}
+
+		if len(checks) > 0 && !containsCheck(checks, finding.GetCheck()) {
+			continue
+		}
+
+		resp.Findings = append(resp.Findings, finding)
+	}
+
+	return resp
+}
+
+func containsCheck(list []pb.ConsistencyCheck, check pb.ConsistencyCheck) bool {
+	for _, v := range list {
+		if v == check {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseChecks - checks by names: CHECK_IP_WITHOUT_ADDRESS or ip_without_address.
+func parseChecks(names []string) ([]pb.ConsistencyCheck, error) {
+	checks := make([]pb.ConsistencyCheck, 0, len(names))
+
+	for _, name := range names {
+		check, ok := pb.ConsistencyCheck_value[strings.ToUpper(name)]
+		if !ok {
+			check, ok = pb.ConsistencyCheck_value["CHECK_"+strings.ToUpper(name)]
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCheck, name)
+		}
+
+		checks = append(checks, pb.ConsistencyCheck(check))
+	}
+
+	return checks, nil
+}
+
+// writeConsistencyText - one line per finding: #id check detail.
+func writeConsistencyText(w *bufio.Writer, resp *pb.ConsistencyResponse) {
+	names := make([]string, 0, len(resp.GetCounts()))
+	for name := range resp.GetCounts() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s %d\n", name, resp.GetCounts()[name])
+	}
+
+	for _, finding := range resp.GetFindings() {
+		fmt.Fprintf(w, "#%d %s %q\n", finding.GetId(), finding.GetCheck(), finding.GetDetail())
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestConsistency(t *testing.T) {
+	CurrentDump = NewDump()
+
+	// 555 loses the domain, 666 is ip without addresses, 777 is a mask without wildcard,
+	// 888 has an unknown URL host, a host under the mask and a duplicate URL.
+	dump := strings.Replace(xml01, `<domain><![CDATA[www.e02.tld]]></domain>
+        <ip>192.168.5.55</ip>`, `<ip>192.168.5.55</ip>`, 1)
+	dump = strings.Replace(dump, `</reg:register>`, `<content id="666" includeTime="2011-01-02T06:06:06" entryType="1" blockType="ip" hash="SSSS">
+        <decision date="2011-01-06" number="6/6/66-6666" org="SIX"/>
+</content>
+<content id="777" includeTime="2011-01-02T07:07:07" entryType="1" blockType="domain-mask" hash="TTTT">
+        <decision date="2011-01-07" number="7/7/77-7777" org="SEVEN"/>
+        <domain><![CDATA[e07.tld]]></domain>
+</content>
+<content id="888" includeTime="2011-01-02T08:08:08" entryType="1" hash="UUUU">
+        <decision date="2011-01-08" number="8/8/88-8888" org="EIGHT"/>
+        <url><![CDATA[http://www.e08.tld/]]></url>
+        <url><![CDATA[http://WWW.e08.tld/#top]]></url>
+        <url><![CDATA[http://sub.e07.tld/x]]></url>
+</content>
+</reg:register>`, 1)
+
+	if err := Parse(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+
+	w := bufio.NewWriter(&out)
+	writeConsistencyText(w, CurrentDump.consistencyReport(nil, 0))
+	w.Flush()
+
+	want := strings.Join([]string{
+		"CHECK_DOMAIN_WITHOUT_DOMAIN 1",
+		"CHECK_DUPLICATE_ELEMENT 1",
+		"CHECK_IP_WITHOUT_ADDRESS 1",
+		"CHECK_MASK_WITHOUT_WILDCARD 1",
+		"CHECK_URL_HOST_NOT_INDEXED 1",
+		`#555 CHECK_DOMAIN_WITHOUT_DOMAIN "domain"`,
+		`#666 CHECK_IP_WITHOUT_ADDRESS "ip"`,
+		`#777 CHECK_MASK_WITHOUT_WILDCARD "e07.tld"`,
+		`#888 CHECK_URL_HOST_NOT_INDEXED "www.e08.tld"`,
+		`#888 CHECK_DUPLICATE_ELEMENT "url http://www.e08.tld/"`,
+	}, "\n") + "\n"
+
+	if out.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, out.String())
+	}
+
+	// 444 changes with the same hash attribute, 666 is fixed with the new one.
+	dump = strings.Replace(dump, `<ip>10.4.4.4</ip>`, `<ip>10.4.4.5</ip>`, 1)
+	dump = strings.Replace(dump, `hash="SSSS"`, `hash="SSST"`, 1)
+	dump = strings.Replace(dump, `org="SIX"/>`, `org="SIX"/>
+        <ip>10.6.6.6</ip>`, 1)
+
+	if err := Parse(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	checks, err := parseChecks([]string{"hash_unchanged", "CHECK_IP_WITHOUT_ADDRESS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := CurrentDump.consistencyReport(checks, 0)
+	if len(resp.GetFindings()) != 1 || resp.GetFindings()[0].GetId() != 444 || resp.GetFindings()[0].GetDetail() != "QQQQ" {
+		t.Errorf("Expected unchanged hash of 444, got %v", resp.GetFindings())
+	}
+
+	if resp := CurrentDump.consistencyReport(nil, 777); len(resp.GetFindings()) != 1 ||
+		resp.GetFindings()[0].GetCheck() != pb.ConsistencyCheck_CHECK_MASK_WITHOUT_WILDCARD {
+		t.Errorf("Expected mask without wildcard of 777, got %v", resp.GetFindings())
+	}
+
+	if _, err := parseChecks([]string{"nonsense"}); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/normalize"
+)
+
+// DNSBL answers.
+const (
+	dnsblTTL         = 300
+	dnsblMaxUDPSize  = 512
+	dnsblMaxTCPSize  = 65535
+	dnsblCodeNetwork = 127 << 24 // 127.0.0.2 + block type.
+	dnsblIdleTimeout = 10 * time.Second
+)
+
+// Errors
+var (
+	ErrDNSBLNoQuestion = errors.New("no question")
+)
+
+// DNSBL - DNS listener answering blocklist queries against the current dump.
+// Reversed IPv4 (4.3.2.1.zone), reversed IPv6 nibbles and domain names (example.com.zone) are supported.
+// A records are 127.0.0.2 + block type of every matched record, TXT records carry content IDs.
+type DNSBL struct {
+	zone string // lowercased, with the trailing dot.
+	udp  net.PacketConn
+	tcp  net.Listener
+}
+
+// ListenDNSBL - listen UDP and TCP on the address for the zone.
+func ListenDNSBL(addr, zone string) (*DNSBL, error) {
+	udp, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen udp: %w", err)
+	}
+
+	tcp, err := net.Listen("tcp", udp.LocalAddr().String())
+	if err != nil {
+		udp.Close()
+
+		return nil, fmt.Errorf("listen tcp: %w", err)
+	}
+
+	return &DNSBL{
+		zone: strings.ToLower(strings.TrimSuffix(zone, ".")) + ".",
+		udp:  udp,
+		tcp:  tcp,
+	}, nil
+}
+
+// Serve - serve UDP and TCP until Close.
+func (s *DNSBL) Serve() {
+	go s.serveTCP()
+
+	buf := make([]byte, dnsblMaxUDPSize)
+
+	for {
+		n, addr, err := s.udp.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			logger.Error.Printf("DNSBL read: %s\n", err.Error())
+
+			continue
+		}
+
+		resp, err := s.handle(buf[:n], dnsblMaxUDPSize)
+		if err != nil {
+			logger.Debug.Printf("DNSBL bad query from %s: %s\n", addr, err.Error())
+
+			continue
+		}
+
+		if _, err := s.udp.WriteTo(resp, addr); err != nil {
+			logger.Debug.Printf("DNSBL write: %s\n", err.Error())
+		}
+	}
+}
+
+func (s *DNSBL) serveTCP() {
+	for {
+		conn, err := s.tcp.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			logger.Error.Printf("DNSBL accept: %s\n", err.Error())
+
+			continue
+		}
+
+		go s.handleTCP(conn)
+	}
+}
+
+// handleTCP - length prefixed queries of the connection.
+func (s *DNSBL) handleTCP(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		var size uint16
+
+		// the query must come in full before the deadline, idle clients are dropped.
+		conn.SetReadDeadline(time.Now().Add(dnsblIdleTimeout))
+
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return
+		}
+
+		req := make([]byte, size)
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+
+		resp, err := s.handle(req, dnsblMaxTCPSize)
+		if err != nil {
+			logger.Debug.Printf("DNSBL bad query from %s: %s\n", conn.RemoteAddr(), err.Error())
+
+			return
+		}
+
+		if err := binary.Write(conn, binary.BigEndian, uint16(len(resp))); err != nil {
+			return
+		}
+
+		if _, err := conn.Write(resp); err != nil {
+			return
+		}
+	}
+}
+
+// Close - stop listening.
+func (s *DNSBL) Close() {
+	s.udp.Close()
+	s.tcp.Close()
+}
+
+// handle - packed response to the packed query, truncated to maxSize.
+func (s *DNSBL) handle(req []byte, maxSize int) ([]byte, error) {
+	var parser dnsmessage.Parser
+
+	header, err := parser.Start(req)
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	q, err := parser.Question()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDNSBLNoQuestion, err)
+	}
+
+	resp := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:                 header.ID,
+			Response:           true,
+			Authoritative:      true,
+			RecursionDesired:   header.RecursionDesired,
+			RCode:              dnsmessage.RCodeSuccess,
+			OpCode:             header.OpCode,
+			RecursionAvailable: false,
+		},
+		Questions: []dnsmessage.Question{q},
+	}
+
+	if header.OpCode != 0 || q.Class != dnsmessage.ClassINET {
+		resp.Header.RCode = dnsmessage.RCodeNotImplemented
+	} else {
+		resp.Header.RCode, resp.Answers = s.answer(q)
+	}
+
+	b, err := resp.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("pack: %w", err)
+	}
+
+	// drop answers, the client retries over TCP.
+	for len(b) > maxSize && len(resp.Answers) > 0 {
+		resp.Header.Truncated = true
+		resp.Answers = resp.Answers[:len(resp.Answers)/2]
+
+		if b, err = resp.Pack(); err != nil {
+			return nil, fmt.Errorf("pack: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+// answer - response code and answers to the question.
+func (s *DNSBL) answer(q dnsmessage.Question) (dnsmessage.RCode, []dnsmessage.Resource) {
+	name := strings.ToLower(q.Name.String())
+
+	if name == s.zone {
+		return dnsmessage.RCodeSuccess, nil
+	}
+
+	if !strings.HasSuffix(name, "."+s.zone) {
+		return dnsmessage.RCodeRefused, nil
+	}
+
+	query := strings.TrimSuffix(name, "."+s.zone)
+
+	if CurrentDump == nil {
+		return dnsmessage.RCodeServerFailure, nil
+	}
+
+	CurrentDump.RLock()
+	defer CurrentDump.RUnlock()
+
+	if CurrentDump.utime == 0 {
+		return dnsmessage.RCodeServerFailure, nil
+	}
+
+	matches := CurrentDump.dnsblLookup(query)
+	if len(matches) == 0 {
+		return dnsmessage.RCodeNameError, nil
+	}
+
+	hdr := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: dnsblTTL}
+
+	var answers []dnsmessage.Resource
+
+	if q.Type == dnsmessage.TypeA || q.Type == dnsmessage.TypeALL {
+		seen := make(map[int32]Nothing)
+
+		for _, m := range matches {
+			cont, ok := CurrentDump.ContentIndex[m.ID]
+			if !ok {
+				continue
+			}
+
+			if _, ok := seen[cont.BlockType]; ok {
+				continue
+			}
+
+			seen[cont.BlockType] = Nothing{}
+
+			var a [4]byte
+
+			binary.BigEndian.PutUint32(a[:], dnsblCode(cont.BlockType))
+
+			hdr.Type = dnsmessage.TypeA
+			answers = append(answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.AResource{A: a}})
+		}
+	}
+
+	if q.Type == dnsmessage.TypeTXT || q.Type == dnsmessage.TypeALL {
+		for _, m := range matches {
+			cont, ok := CurrentDump.ContentIndex[m.ID]
+			if !ok {
+				continue
+			}
+
+			txt := fmt.Sprintf("id=%d block=%s entry=%s match=%s", m.ID, blockTypeName(cont.BlockType), cont.EntryTypeString, m.Key)
+
+			hdr.Type = dnsmessage.TypeTXT
+			answers = append(answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.TXTResource{TXT: []string{txt}}})
+		}
+	}
+
+	return dnsmessage.RCodeSuccess, answers
+}
+
+// dnsblCode - A record of the block type: 127.0.0.2 url, .3 https, .4 domain, .5 mask, .6 ip.
+func dnsblCode(blockType int32) uint32 {
+	return dnsblCodeNetwork | uint32(2+blockType)
+}
+
+// dnsblLookup - search by reversed IPv4, reversed IPv6 nibbles or domain name.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) dnsblLookup(query string) []Match {
+	labels := strings.Split(query, ".")
+
+	if ip4, ok := parseReversedIPv4(labels); ok {
+		return dump.searchIPv4(ip4)
+	}
+
+	if ip6, ok := parseReversedIPv6(labels); ok {
+		return dump.searchIPv6(ip6)
+	}
+
+	domain := normalize.Domain(query)
+
+	return append(dump.searchDomain(domain), dump.searchDomainMask(domain)...)
+}
+
+// parseReversedIPv4 - 4.3.2.1 is 1.2.3.4.
+func parseReversedIPv4(labels []string) (uint32, bool) {
+	if len(labels) != 4 {
+		return 0, false
+	}
+
+	var ip4 uint32
+
+	for i := 3; i >= 0; i-- {
+		n, err := strconv.ParseUint(labels[i], 10, 8)
+		if err != nil {
+			return 0, false
+		}
+
+		ip4 = ip4<<8 | uint32(n)
+	}
+
+	return ip4, true
+}
+
+// parseReversedIPv6 - 32 nibbles from the least significant one.
+func parseReversedIPv6(labels []string) (net.IP, bool) {
+	if len(labels) != 32 {
+		return nil, false
+	}
+
+	ip6 := make(net.IP, net.IPv6len)
+
+	for i, label := range labels {
+		n, err := strconv.ParseUint(label, 16, 4)
+		if err != nil || len(label) != 1 {
+			return nil, false
+		}
+
+		pos := 31 - i
+		if pos%2 == 0 {
+			ip6[pos/2] |= byte(n) << 4
+		} else {
+			ip6[pos/2] |= byte(n)
+		}
+	}
+
+	return ip6, true
+}
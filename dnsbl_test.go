@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsblQuery - sends the query over the network and parses the response.
+func dnsblQuery(t *testing.T, network, addr, name string, qtype dnsmessage.Type) *dnsmessage.Message {
+	t.Helper()
+
+	req := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: 42, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+
+	b, err := req.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	if network == "tcp" {
+		b = append(binary.BigEndian.AppendUint16(nil, uint16(len(b))), b...)
+	}
+
+	if _, err := conn.Write(b); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, dnsblMaxTCPSize)
+
+	var n int
+
+	if network == "tcp" {
+		var size uint16
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			t.Fatal(err)
+		}
+
+		n, err = io.ReadFull(conn, buf[:size])
+	} else {
+		n, err = conn.Read(buf)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &dnsmessage.Message{}
+	if err := resp.Unpack(buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Header.ID != 42 {
+		t.Errorf("Expected ID 42, got %d", resp.Header.ID)
+	}
+
+	return resp
+}
+
+func TestDNSBL(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := ListenDNSBL("127.0.0.1:0", "RKN.local.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer s.Close()
+
+	go s.Serve()
+
+	addr := s.udp.LocalAddr().String()
+
+	tests := []struct {
+		name    string
+		network string
+		qname   string
+		qtype   dnsmessage.Type
+		rcode   dnsmessage.RCode
+		want    []string
+	}{
+		{"ipv4", "udp", "100.0.168.192.rkn.local.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, []string{"127.0.0.3", "127.0.0.4", "127.0.0.6"}},
+		{"subnet", "udp", "1.1.4.10.rkn.local.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, []string{"127.0.0.6"}},
+		{"domain txt", "tcp", "WWW.e02.tld.rkn.local.", dnsmessage.TypeTXT, dnsmessage.RCodeSuccess,
+			[]string{"id=222 block=domain entry=15_1 match=www.e02.tld", "id=555 block=domain entry=15_1 match=www.e02.tld"}},
+		{"ipv6", "udp", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.4.0.0.0.4.4.d.f.rkn.local.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, []string{"127.0.0.6"}},
+		{"no data", "udp", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.4.0.0.0.4.4.d.f.rkn.local.", dnsmessage.TypeAAAA, dnsmessage.RCodeSuccess, nil},
+		{"not listed", "udp", "4.3.2.1.rkn.local.", dnsmessage.TypeA, dnsmessage.RCodeNameError, nil},
+		{"apex", "udp", "rkn.local.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, nil},
+		{"other zone", "udp", "example.com.", dnsmessage.TypeA, dnsmessage.RCodeRefused, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := dnsblQuery(t, tt.network, addr, tt.qname, tt.qtype)
+
+			if resp.Header.RCode != tt.rcode {
+				t.Fatalf("Expected %v, got %v", tt.rcode, resp.Header.RCode)
+			}
+
+			var got []string
+
+			for _, answer := range resp.Answers {
+				switch body := answer.Body.(type) {
+				case *dnsmessage.AResource:
+					got = append(got, net.IP(body.A[:]).String())
+				case *dnsmessage.TXTResource:
+					got = append(got, strings.Join(body.TXT, ""))
+				}
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDNSBLLookup(t *testing.T) {
+	CurrentDump = NewDump()
+
+	masked := strings.Replace(xml01, `blockType="domain" hash="PPPP"`, `blockType="domain-mask" hash="PPPP"`, 1)
+	if err := Parse(strings.NewReader(masked)); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := CurrentDump.dnsblLookup("a.b.www.e02.tld"); len(got) != 1 || got[0].ID != 555 {
+		t.Errorf("Expected mask 555, got %v", got)
+	}
+
+	if got := CurrentDump.dnsblLookup("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.4.0.0.0.4.4.d.f"); len(got) != 1 || got[0].ID != 444 {
+		t.Errorf("Expected fd44:4::1 of 444, got %v", got)
+	}
+}
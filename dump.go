@@ -9,6 +9,8 @@ import (
 	"github.com/yl2chen/cidranger"
 
 	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/normalize"
+	pb "github.com/usher2/u2ckdump/msg"
 )
 
 type (
@@ -42,6 +44,8 @@ func (s *ParseStatistics) Update() {
 type Dump struct {
 	sync.RWMutex
 	utime             int64
+	generation        uint64 // incremented on every applied dump
+	parsing           bool   // Parse is applying records, page tokens are not accepted
 	IPv4Index         Uint32SearchIndex
 	IPv6Index         StringSearchIndex
 	subnetIPv4Index   StringSearchIndex
@@ -56,9 +60,25 @@ type Dump struct {
 	orgIndex          StringSearchIndex
 	packedOrgIndex    map[uint64]string
 	withoutDecisionNo IntArrayStorage
+	includeTimeIndex  TimeSearchIndex
+	recordTimeIndex   TimeSearchIndex
+	elementTimeIndex  TimeSearchIndex
+	lint              lintIndex
+	findings          findingIndex
+	consistency       []*pb.Finding // refreshed after Cleanup
+	tombstones        *Tombstones
+	history           *History
 }
 
 func NewDump() *Dump {
+	dump := newDump()
+	dump.tombstones = NewTombstones()
+
+	return dump
+}
+
+// newDump - dump without tombstones.
+func newDump() *Dump {
 	return &Dump{
 		utime:             0,
 		IPv4Index:         make(Uint32SearchIndex),
@@ -75,6 +95,8 @@ func NewDump() *Dump {
 		orgIndex:          make(StringSearchIndex),
 		packedOrgIndex:    make(map[uint64]string),
 		withoutDecisionNo: make(IntArrayStorage, 0),
+		lint:              make(lintIndex),
+		findings:          make(findingIndex),
 	}
 }
 
@@ -98,7 +120,9 @@ func (d *Dump) InsertToSubnetIPv4Index(subnet4 string, id int32) {
 	if d.subnetIPv4Index.Insert(subnet4, id) {
 		_, network, err := net.ParseCIDR(subnet4)
 		if err != nil {
-			logger.Debug.Printf("Can't parse CIDR: %s: %s\n", subnet4, err.Error())
+			logger.Error.Printf("Can't parse CIDR: %s: %s\n", subnet4, err.Error())
+
+			return
 		}
 		err = d.netTree.Insert(cidranger.NewBasicRangerEntry(*network))
 		if err != nil {
@@ -111,7 +135,9 @@ func (d *Dump) RemoveFromSubnetIPv4Index(subnet4 string, id int32) {
 	if d.subnetIPv4Index.Remove(subnet4, id) {
 		_, network, err := net.ParseCIDR(subnet4)
 		if err != nil {
-			logger.Debug.Printf("Can't parse CIDR: %s: %s\n", subnet4, err.Error())
+			logger.Error.Printf("Can't parse CIDR: %s: %s\n", subnet4, err.Error())
+
+			return
 		}
 		_, err = d.netTree.Remove(*network)
 		if err != nil {
@@ -124,7 +150,9 @@ func (d *Dump) InsertToSubnetIPv6Index(subnet6 string, id int32) {
 	if d.subnetIPv6Index.Insert(subnet6, id) {
 		_, network, err := net.ParseCIDR(subnet6)
 		if err != nil {
-			logger.Debug.Printf("Can't parse CIDR: %s: %s\n", subnet6, err.Error())
+			logger.Error.Printf("Can't parse CIDR: %s: %s\n", subnet6, err.Error())
+
+			return
 		}
 		err = d.netTree.Insert(cidranger.NewBasicRangerEntry(*network))
 		if err != nil {
@@ -137,7 +165,9 @@ func (d *Dump) RemoveFromSubnetIPv6Index(subnet6 string, id int32) {
 	if d.subnetIPv6Index.Remove(subnet6, id) {
 		_, network, err := net.ParseCIDR(subnet6)
 		if err != nil {
-			logger.Debug.Printf("Can't parse CIDR: %s: %s\n", subnet6, err.Error())
+			logger.Error.Printf("Can't parse CIDR: %s: %s\n", subnet6, err.Error())
+
+			return
 		}
 		_, err = d.netTree.Remove(*network)
 		if err != nil {
@@ -218,6 +248,103 @@ func (d *Dump) RemoveFromEntryTypeIndex(entryType string, id int32) {
 	d.entryTypeIndex.Remove(entryType, id)
 }
 
+// buildTimeIndexes - rebuild time sorted indexes from all records.
+func (d *Dump) buildTimeIndexes() {
+	d.includeTimeIndex = make(TimeSearchIndex, 0, len(d.ContentIndex))
+	d.recordTimeIndex = make(TimeSearchIndex, 0, len(d.ContentIndex))
+	d.elementTimeIndex = d.elementTimeIndex[:0]
+
+	for id, cont := range d.ContentIndex {
+		d.includeTimeIndex = append(d.includeTimeIndex, timeEntry{Ts: cont.IncludeTime, ID: id})
+
+		if cont.Ts != 0 {
+			d.recordTimeIndex = append(d.recordTimeIndex, timeEntry{Ts: cont.Ts, ID: id})
+		}
+
+		d.elementTimeIndex = cont.appendElementTimes(d.elementTimeIndex)
+	}
+
+	d.includeTimeIndex.Sort()
+	d.recordTimeIndex.Sort()
+	d.elementTimeIndex.Sort()
+}
+
+// buildPackedOrgIndex - rebuild org hash to org name index.
+func (d *Dump) buildPackedOrgIndex() {
+	for org := range d.packedOrgIndex {
+		delete(d.packedOrgIndex, org)
+	}
+
+	for org := range d.orgIndex {
+		d.packedOrgIndex[String2fnv2uint64(org)] = org
+	}
+}
+
+// insertToIndexes - index packed record in all indexes.
+func (d *Dump) insertToIndexes(cont *PackedContent) {
+	for _, ip4 := range cont.IPv4 {
+		d.InsertToIPv4Index(ip4.IPv4, cont.ID)
+	}
+
+	for _, ip6 := range cont.IPv6 {
+		d.InsertToIPv6Index(string(ip6.IPv6), cont.ID)
+	}
+
+	for _, subnet6 := range cont.SubnetIPv6 {
+		d.InsertToSubnetIPv6Index(subnet6.SubnetIPv6, cont.ID)
+	}
+
+	for _, subnet4 := range cont.SubnetIPv4 {
+		d.InsertToSubnetIPv4Index(subnet4.SubnetIPv4, cont.ID)
+	}
+
+	for _, u := range cont.URL {
+		d.InsertToURLIndex(normalize.URL(u.URL), cont.ID)
+	}
+
+	for _, domain := range cont.Domain {
+		d.InsertToDomainIndex(normalize.Domain(domain.Domain), cont.ID)
+	}
+
+	d.InsertToDecisionIndex(cont.Decision, cont.ID)
+	d.InsertToDecisionOrgIndex(cont.DecisionOrg, cont.ID)
+	d.InsertToDecisionWithoutNoIndex(cont.DecisionNumber, cont.ID)
+	d.InsertToEntryTypeIndex(cont.EntryTypeString, cont.ID)
+}
+
+// removeFromIndexes - remove packed record from all indexes.
+func (d *Dump) removeFromIndexes(cont *PackedContent) {
+	for _, ip4 := range cont.IPv4 {
+		d.RemoveFromIPv4Index(ip4.IPv4, cont.ID)
+	}
+
+	for _, ip6 := range cont.IPv6 {
+		ip6 := string(ip6.IPv6)
+		d.RemoveFromIPv6Index(ip6, cont.ID)
+	}
+
+	for _, subnet6 := range cont.SubnetIPv6 {
+		d.RemoveFromSubnetIPv6Index(subnet6.SubnetIPv6, cont.ID)
+	}
+
+	for _, subnet4 := range cont.SubnetIPv4 {
+		d.RemoveFromSubnetIPv4Index(subnet4.SubnetIPv4, cont.ID)
+	}
+
+	for _, u := range cont.URL {
+		d.RemoveFromURLIndex(normalize.URL(u.URL), cont.ID)
+	}
+
+	for _, domain := range cont.Domain {
+		d.RemoveFromDomainIndex(normalize.Domain(domain.Domain), cont.ID)
+	}
+
+	d.RemoveFromDecisionIndex(cont.Decision, cont.ID)
+	d.RemoveFromDecisionOrgIndex(cont.DecisionOrg, cont.ID)
+	d.RemoveFromDecisionWithoutNoIndex(cont.ID)
+	d.RemoveFromEntryTypeIndex(cont.EntryTypeString, cont.ID)
+}
+
 var CurrentDump = NewDump()
 
 type Reg struct {
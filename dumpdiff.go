@@ -0,0 +1,379 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Diff report formats.
+const (
+	diffFormatJSON     = "json"
+	diffFormatCSV      = "csv"
+	diffFormatMarkdown = "md"
+)
+
+// Record changes.
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+// Errors
+var (
+	ErrUnknownDiffFormat = errors.New("unknown diff format")
+	ErrNoDumpArchive     = errors.New("no archived dump")
+)
+
+// dumpDiffReport - differences between two dumps.
+type dumpDiffReport struct {
+	PrevUpdateTime int64          `json:"prev_update_time"`
+	UpdateTime     int64          `json:"update_time"`
+	Added          int            `json:"added"`
+	Removed        int            `json:"removed"`
+	Changed        int            `json:"changed"`
+	Groups         []diffGroup    `json:"groups"`
+	Records        []recordChange `json:"records"`
+}
+
+// diffGroup - counts of changes by org, entry type or block type.
+type diffGroup struct {
+	Dimension string `json:"dimension"`
+	Key       string `json:"key"`
+	Added     int    `json:"added"`
+	Removed   int    `json:"removed"`
+	Changed   int    `json:"changed"`
+}
+
+// recordChange - added, removed or changed record. Org, entry type and block type are of the new version,
+// of the old one for removed records.
+type recordChange struct {
+	Change    string         `json:"change"`
+	ID        int32          `json:"id"`
+	Org       string         `json:"org"`
+	EntryType string         `json:"entry_type"`
+	BlockType string         `json:"block_type"`
+	Decision  string         `json:"decision"`
+	Fields    []string       `json:"fields,omitempty"` // short form of the diff.
+	Diff      *pb.RecordDiff `json:"diff,omitempty"`
+}
+
+// diffDumps - records added, removed and changed by RecordHash with field level diffs.
+// Expects both dumps to be read locked by the caller.
+func diffDumps(prev, cur *Dump) *dumpDiffReport {
+	report := &dumpDiffReport{PrevUpdateTime: prev.utime, UpdateTime: cur.utime}
+
+	for id, pack := range cur.ContentIndex {
+		prevPack, ok := prev.ContentIndex[id]
+
+		switch {
+		case !ok:
+			report.Records = append(report.Records, newRecordChange(changeAdded, pack, nil))
+		case prevPack.RecordHash != pack.RecordHash:
+			report.Records = append(report.Records, newRecordChange(changeChanged, pack, prevPack))
+		}
+	}
+
+	for id, prevPack := range prev.ContentIndex {
+		if _, ok := cur.ContentIndex[id]; !ok {
+			report.Records = append(report.Records, newRecordChange(changeRemoved, prevPack, nil))
+		}
+	}
+
+	sort.Slice(report.Records, func(i, j int) bool { return report.Records[i].ID < report.Records[j].ID })
+
+	groups := make(map[[2]string]*diffGroup)
+
+	for _, rec := range report.Records {
+		for _, key := range [][2]string{{"org", rec.Org}, {"entry_type", rec.EntryType}, {"block_type", rec.BlockType}} {
+			group, ok := groups[key]
+			if !ok {
+				group = &diffGroup{Dimension: key[0], Key: key[1]}
+				groups[key] = group
+			}
+
+			countChange(rec.Change, &group.Added, &group.Removed, &group.Changed)
+		}
+
+		countChange(rec.Change, &report.Added, &report.Removed, &report.Changed)
+	}
+
+	for _, group := range groups {
+		report.Groups = append(report.Groups, *group)
+	}
+
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Dimension != report.Groups[j].Dimension {
+			return report.Groups[i].Dimension < report.Groups[j].Dimension
+		}
+
+		return report.Groups[i].Key < report.Groups[j].Key
+	})
+
+	return report
+}
+
+func countChange(change string, added, removed, changed *int) {
+	switch change {
+	case changeAdded:
+		*added++
+	case changeRemoved:
+		*removed++
+	case changeChanged:
+		*changed++
+	}
+}
+
+func newRecordChange(change string, pack, prevPack *PackedContent) recordChange {
+	rec := pack.Record()
+
+	res := recordChange{
+		Change:    change,
+		ID:        pack.ID,
+		Org:       pack.DecisionOrg,
+		EntryType: pack.EntryTypeString,
+		BlockType: blockTypeName(pack.BlockType),
+		Decision:  strings.TrimSpace(rec.GetDecision().GetNumber() + " " + rec.GetDecision().GetDate()),
+	}
+
+	if prevPack != nil {
+		res.Diff = diffRecords(prevPack.Record(), rec)
+		res.Fields = diffFields(res.Diff)
+	}
+
+	return res
+}
+
+// diffFields - short form of the diff: ip4 +1 -2, decision, ...
+func diffFields(diff *pb.RecordDiff) []string {
+	var fields []string
+
+	for _, f := range []struct {
+		name           string
+		added, removed []string
+	}{
+		{"ip4", diff.GetIp4Added(), diff.GetIp4Removed()},
+		{"ip6", diff.GetIp6Added(), diff.GetIp6Removed()},
+		{"subnet4", diff.GetSubnet4Added(), diff.GetSubnet4Removed()},
+		{"subnet6", diff.GetSubnet6Added(), diff.GetSubnet6Removed()},
+		{"domain", diff.GetDomainAdded(), diff.GetDomainRemoved()},
+		{"url", diff.GetUrlAdded(), diff.GetUrlRemoved()},
+	} {
+		if len(f.added) > 0 || len(f.removed) > 0 {
+			fields = append(fields, fmt.Sprintf("%s +%d -%d", f.name, len(f.added), len(f.removed)))
+		}
+	}
+
+	if diff.GetDecisionChanged() {
+		fields = append(fields, "decision")
+	}
+
+	if diff.GetEntryTypeChanged() {
+		fields = append(fields, "entry type")
+	}
+
+	if diff.GetBlockTypeChanged() {
+		fields = append(fields, "block type")
+	}
+
+	// only times or attributes.
+	if len(fields) == 0 {
+		fields = append(fields, "other")
+	}
+
+	return fields
+}
+
+func writeDiffJSON(w io.Writer, report *dumpDiffReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
+
+func writeDiffCSV(w io.Writer, report *dumpDiffReport) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"change", "id", "org", "entry_type", "block_type", "decision", "fields"})
+
+	for _, rec := range report.Records {
+		cw.Write([]string{rec.Change, strconv.Itoa(int(rec.ID)), rec.Org, rec.EntryType, rec.BlockType, rec.Decision, strings.Join(rec.Fields, "; ")})
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func writeDiffMarkdown(w io.Writer, report *dumpDiffReport) error {
+	fmt.Fprintf(w, "# Registry changes %s → %s\n\n", diffTime(report.PrevUpdateTime), diffTime(report.UpdateTime))
+	fmt.Fprintf(w, "| added | removed | changed |\n|---:|---:|---:|\n| %d | %d | %d |\n", report.Added, report.Removed, report.Changed)
+
+	for _, dimension := range []struct{ key, title string }{{"org", "Org"}, {"entry_type", "Entry type"}, {"block_type", "Block type"}} {
+		fmt.Fprintf(w, "\n## By %s\n\n| %s | added | removed | changed |\n|---|---:|---:|---:|\n", strings.ToLower(dimension.title), dimension.title)
+
+		for _, group := range report.Groups {
+			if group.Dimension == dimension.key {
+				fmt.Fprintf(w, "| %s | %d | %d | %d |\n", markdownCell(group.Key), group.Added, group.Removed, group.Changed)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\n## Records\n\n| change | id | org | entry type | block type | decision | fields |\n|---|---:|---|---|---|---|---|\n")
+
+	for _, rec := range report.Records {
+		fmt.Fprintf(w, "| %s | %d | %s | %s | %s | %s | %s |\n", rec.Change, rec.ID, markdownCell(rec.Org), rec.EntryType, rec.BlockType,
+			markdownCell(rec.Decision), markdownCell(strings.Join(rec.Fields, ", ")))
+	}
+
+	return nil
+}
+
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func diffTime(utime int64) string {
+	return time.Unix(utime, 0).In(locationMSK).Format(time.RFC3339)
+}
+
+// diffMain - diff command: compares two dump files or two archived dumps.
+func diffMain(args []string) int {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	confArchiveDir := fs.String("d", "", "Archived dumps dir, arguments are archived dump IDs: name time, RFC 3339 or unix time, last or prev")
+	confFormat := fs.String("f", diffFormatMarkdown, "Format: json, csv or md")
+	confOutput := fs.String("o", "", "Output file, empty is stdout")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: u2ckdump diff [flags] <old dump> <new dump>\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+
+		return 2
+	}
+
+	var write func(w io.Writer, report *dumpDiffReport) error
+
+	switch *confFormat {
+	case diffFormatJSON:
+		write = writeDiffJSON
+	case diffFormatCSV:
+		write = writeDiffCSV
+	case diffFormatMarkdown:
+		write = writeDiffMarkdown
+	default:
+		logger.Error.Printf("%s: %s\n", ErrUnknownDiffFormat.Error(), *confFormat)
+
+		return 1
+	}
+
+	var dumps [2]*Dump
+
+	for i, arg := range fs.Args() {
+		filename := arg
+
+		if *confArchiveDir != "" {
+			var err error
+			if filename, err = findDumpArchive(*confArchiveDir, arg); err != nil {
+				logger.Error.Printf("Can't find dump: %s\n", err.Error())
+
+				return 1
+			}
+		}
+
+		dump, err := loadDump(filename, "", "", "")
+		if err != nil {
+			logger.Error.Printf("Can't load dump %s: %s\n", filename, err.Error())
+
+			return 1
+		}
+
+		dumps[i] = dump
+	}
+
+	dumps[0].RLock()
+	dumps[1].RLock()
+	report := diffDumps(dumps[0], dumps[1])
+	dumps[1].RUnlock()
+	dumps[0].RUnlock()
+
+	out := os.Stdout
+	if *confOutput != "" {
+		f, err := os.Create(*confOutput)
+		if err != nil {
+			logger.Error.Printf("Can't create output: %s\n", err.Error())
+
+			return 1
+		}
+
+		defer f.Close()
+
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+
+	if err := write(w, report); err != nil {
+		logger.Error.Printf("Can't write report: %s\n", err.Error())
+
+		return 1
+	}
+
+	if err := w.Flush(); err != nil {
+		logger.Error.Printf("Can't write report: %s\n", err.Error())
+
+		return 1
+	}
+
+	return 0
+}
+
+// findDumpArchive - archived dump by the ID: the time of the name, RFC 3339 or unix time, last or prev.
+func findDumpArchive(dir, id string) (string, error) {
+	archives, err := listDumpArchives(dir)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case id == "last" && len(archives) > 0:
+		return archives[len(archives)-1].Filename, nil
+	case id == "prev" && len(archives) > 1:
+		return archives[len(archives)-2].Filename, nil
+	}
+
+	for _, archive := range archives {
+		name := filepath.Base(archive.Filename)
+
+		if id == name ||
+			id == strings.TrimSuffix(strings.TrimPrefix(name, "dump-"), ".zip") ||
+			id == strconv.FormatInt(archive.Time.Unix(), 10) {
+			return archive.Filename, nil
+		}
+
+		if t, err := time.Parse(time.RFC3339, id); err == nil && t.Equal(archive.Time) {
+			return archive.Filename, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrNoDumpArchive, id)
+}
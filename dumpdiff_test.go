@@ -0,0 +1,103 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffDumps(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	prev := CurrentDump
+
+	// 333 is removed, 444 gets the other IP and decision, 666 is added.
+	next := dumpWithout("2011-01-02T01:01:01+03:00", "333")
+	next = strings.Replace(next, `<ip>10.4.4.4</ip>`, `<ip>10.4.4.5</ip>`, 1)
+	next = strings.Replace(next, `number="4/4/44-4444"`, `number="4/4/44-4445"`, 1)
+	next = strings.Replace(next, `</reg:register>`, `<content id="666" includeTime="2011-01-02T06:06:06" entryType="2" blockType="domain" hash="SSSS">
+        <decision date="2011-01-06" number="6/6/66-6666" org="SIX"/>
+        <domain><![CDATA[www.e06.tld]]></domain>
+</content>
+</reg:register>`, 1)
+
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(next)); err != nil {
+		t.Fatal(err)
+	}
+
+	report := diffDumps(prev, CurrentDump)
+
+	if report.Added != 1 || report.Removed != 1 || report.Changed != 1 {
+		t.Fatalf("Expected 1 added, 1 removed, 1 changed, got %d %d %d", report.Added, report.Removed, report.Changed)
+	}
+
+	var csv strings.Builder
+
+	if err := writeDiffCSV(&csv, report); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `change,id,org,entry_type,block_type,decision,fields
+removed,333,THREE,15_1,ip,3/3/33-3333 2001-01-03,
+changed,444,FOUR,15_1,ip,4/4/44-4445 2001-01-04,ip4 +1 -1; decision
+added,666,SIX,15_2,domain,6/6/66-6666 2011-01-06,
+`
+	if csv.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, csv.String())
+	}
+
+	var md strings.Builder
+
+	if err := writeDiffMarkdown(&md, report); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"# Registry changes 2011-01-01T01:01:01+03:00 → 2011-01-02T01:01:01+03:00",
+		"| FOUR | 0 | 0 | 1 |",
+		"| ip | 0 | 1 | 1 |",
+		"| 15_2 | 1 | 0 | 0 |",
+		"| changed | 444 | FOUR | 15_1 | ip | 4/4/44-4445 2001-01-04 | ip4 +1 -1, decision |",
+	} {
+		if !strings.Contains(md.String(), line+"\n") {
+			t.Errorf("Expected %q in\n%s", line, md.String())
+		}
+	}
+}
+
+func TestFindDumpArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	writeDumpArchive(t, filepath.Join(dir, "dump-2011-01-01T01:01:01+0300.zip"), xml01)
+	writeDumpArchive(t, filepath.Join(dir, "dump-2011-01-02T01:01:01+0300.zip"), xml01)
+
+	tests := []struct {
+		id   string
+		want string
+		err  bool
+	}{
+		{"last", "dump-2011-01-02T01:01:01+0300.zip", false},
+		{"prev", "dump-2011-01-01T01:01:01+0300.zip", false},
+		{"2011-01-01T01:01:01+0300", "dump-2011-01-01T01:01:01+0300.zip", false},
+		{"2011-01-01T01:01:01+03:00", "dump-2011-01-01T01:01:01+0300.zip", false},
+		{"1293919261", "dump-2011-01-02T01:01:01+0300.zip", false},
+		{"2011-01-03T01:01:01+03:00", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := findDumpArchive(dir, tt.id)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: Expected error %v, got %v", tt.id, tt.err, err)
+		}
+
+		if tt.want != "" && got != filepath.Join(dir, tt.want) {
+			t.Errorf("%s: Expected %s, got %s", tt.id, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// Export formats.
+const (
+	exportFormatCIDR  = "cidr"
+	exportFormatNft   = "nft"
+	exportFormatIPSet = "ipset"
+)
+
+// ipsetMinMaxElem - ipset default maxelem.
+const ipsetMinMaxElem = 65536
+
+// Errors
+var (
+	ErrUnknownExportFormat = errors.New("unknown export format")
+	ErrUnknownBlockType    = errors.New("unknown block type")
+)
+
+// exportFilter - records to export, empty sets match everything.
+type exportFilter struct {
+	blockTypes map[int32]Nothing
+	entryTypes map[string]Nothing
+}
+
+// newExportFilter - filter from comma separated block type names and entry type keys.
+func newExportFilter(blockTypes, entryTypes string) (*exportFilter, error) {
+	filter := &exportFilter{
+		blockTypes: make(map[int32]Nothing),
+		entryTypes: make(map[string]Nothing),
+	}
+
+	for _, name := range splitList(blockTypes) {
+		blockType, ok := blockTypeByName(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownBlockType, name)
+		}
+
+		filter.blockTypes[blockType] = Nothing{}
+	}
+
+	for _, key := range splitList(entryTypes) {
+		filter.entryTypes[key] = Nothing{}
+	}
+
+	return filter, nil
+}
+
+func (filter *exportFilter) match(pack *PackedContent) bool {
+	if len(filter.blockTypes) > 0 {
+		if _, ok := filter.blockTypes[pack.BlockType]; !ok {
+			return false
+		}
+	}
+
+	if len(filter.entryTypes) > 0 {
+		if _, ok := filter.entryTypes[pack.EntryTypeString]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchAny - any of the records matches.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) matchAny(filter *exportFilter, ids IntArrayStorage) bool {
+	for _, id := range ids {
+		if cont, ok := dump.ContentIndex[id]; ok && filter.match(cont) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportPrefixes - aggregated IPv4 and IPv6 prefixes of the matched records.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) exportPrefixes(filter *exportFilter) (v4, v6 []netip.Prefix) {
+	for ip4, ids := range dump.IPv4Index {
+		if dump.matchAny(filter, ids) {
+			addr := netip.AddrFrom4([4]byte{byte(ip4 >> 24), byte(ip4 >> 16), byte(ip4 >> 8), byte(ip4)})
+			v4 = append(v4, netip.PrefixFrom(addr, 32))
+		}
+	}
+
+	for ip6, ids := range dump.IPv6Index {
+		addr, ok := netip.AddrFromSlice([]byte(ip6))
+		if ok && dump.matchAny(filter, ids) {
+			v6 = append(v6, netip.PrefixFrom(addr, 128))
+		}
+	}
+
+	for _, index := range []StringSearchIndex{dump.subnetIPv4Index, dump.subnetIPv6Index} {
+		for subnet, ids := range index {
+			prefix, err := netip.ParsePrefix(subnet)
+			if err != nil {
+				logger.Warning.Printf("Bad subnet: %s\n", subnet)
+
+				continue
+			}
+
+			if !dump.matchAny(filter, ids) {
+				continue
+			}
+
+			if prefix.Addr().Is4() {
+				v4 = append(v4, prefix)
+			} else {
+				v6 = append(v6, prefix)
+			}
+		}
+	}
+
+	return aggregatePrefixes(v4), aggregatePrefixes(v6)
+}
+
+// writeCIDRList - one prefix per line, IPv4 first.
+func writeCIDRList(w io.Writer, v4, v6 []netip.Prefix) {
+	for _, prefixes := range [][]netip.Prefix{v4, v6} {
+		for _, prefix := range prefixes {
+			fmt.Fprintln(w, prefix.String())
+		}
+	}
+}
+
+// writeNftSets - nftables table with interval sets, it is loaded with nft -f.
+func writeNftSets(w io.Writer, family, table, set string, v4, v6 []netip.Prefix) {
+	fmt.Fprintf(w, "table %s %s {\n", family, table)
+	writeNftSet(w, set+"_v4", "ipv4_addr", v4)
+	writeNftSet(w, set+"_v6", "ipv6_addr", v6)
+	fmt.Fprintln(w, "}")
+}
+
+func writeNftSet(w io.Writer, name, addrType string, prefixes []netip.Prefix) {
+	fmt.Fprintf(w, "\tset %s {\n", name)
+	fmt.Fprintf(w, "\t\ttype %s\n", addrType)
+	fmt.Fprintln(w, "\t\tflags interval")
+
+	// empty elements list is a syntax error.
+	if len(prefixes) > 0 {
+		fmt.Fprintln(w, "\t\telements = {")
+
+		for i, prefix := range prefixes {
+			sep := ","
+			if i == len(prefixes)-1 {
+				sep = ""
+			}
+
+			fmt.Fprintf(w, "\t\t\t%s%s\n", prefix.String(), sep)
+		}
+
+		fmt.Fprintln(w, "\t\t}")
+	}
+
+	fmt.Fprintln(w, "\t}")
+}
+
+// writeIPSetRestore - hash:net sets, it is loaded with ipset restore.
+func writeIPSetRestore(w io.Writer, set string, v4, v6 []netip.Prefix) {
+	writeIPSet(w, set+"_v4", "inet", v4)
+	writeIPSet(w, set+"_v6", "inet6", v6)
+}
+
+func writeIPSet(w io.Writer, name, family string, prefixes []netip.Prefix) {
+	fmt.Fprintf(w, "create %s hash:net family %s maxelem %d -exist\n", name, family, max(ipsetMinMaxElem, len(prefixes)))
+	fmt.Fprintf(w, "flush %s\n", name)
+
+	for _, prefix := range prefixes {
+		fmt.Fprintf(w, "add %s %s\n", name, prefix.String())
+	}
+}
+
+// exportMain - export command: parses the dump and writes the blocked addresses as kernel sets.
+func exportMain(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	confDumpFile := fs.String("i", "res/dump.xml", "Dump file, zipped or plain")
+	confFormat := fs.String("f", exportFormatCIDR, "Format: cidr, nft or ipset")
+	confOutput := fs.String("o", "", "Output file, empty is stdout")
+	confBlockTypes := fs.String("b", "", "Comma separated block types: url, https, domain, mask, ip, empty is all")
+	confEntryTypes := fs.String("e", "", "Comma separated entry type keys, e.g. 15_1,15_3, empty is all")
+	confSet := fs.String("set", "u2ckdump", "Set name prefix, _v4 and _v6 are appended")
+	confNftFamily := fs.String("nft-family", "inet", "nftables table family")
+	confNftTable := fs.String("nft-table", "u2ckdump", "nftables table name")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	filter, err := newExportFilter(*confBlockTypes, *confEntryTypes)
+	if err != nil {
+		logger.Error.Printf("Bad filter: %s\n", err.Error())
+
+		return 1
+	}
+
+	switch *confFormat {
+	case exportFormatCIDR, exportFormatNft, exportFormatIPSet:
+	default:
+		logger.Error.Printf("%s: %s\n", ErrUnknownExportFormat.Error(), *confFormat)
+
+		return 1
+	}
+
+	CurrentDump = NewDump()
+
+	if err := parseDumpFile(*confDumpFile); err != nil {
+		logger.Error.Printf("Can't parse dump: %s\n", err.Error())
+
+		return 1
+	}
+
+	CurrentDump.RLock()
+	v4, v6 := CurrentDump.exportPrefixes(filter)
+	CurrentDump.RUnlock()
+
+	out := os.Stdout
+	if *confOutput != "" {
+		f, err := os.Create(*confOutput)
+		if err != nil {
+			logger.Error.Printf("Can't create output: %s\n", err.Error())
+
+			return 1
+		}
+
+		defer f.Close()
+
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+
+	switch *confFormat {
+	case exportFormatNft:
+		writeNftSets(w, *confNftFamily, *confNftTable, *confSet, v4, v6)
+	case exportFormatIPSet:
+		writeIPSetRestore(w, *confSet, v4, v6)
+	default:
+		writeCIDRList(w, v4, v6)
+	}
+
+	if err := w.Flush(); err != nil {
+		logger.Error.Printf("Can't write output: %s\n", err.Error())
+
+		return 1
+	}
+
+	return 0
+}
+
+// blockTypeByName - block type by the name from blockTypeName.
+func blockTypeByName(name string) (int32, bool) {
+	for _, blockType := range []int32{BlockTypeURL, BlockTypeHTTPS, BlockTypeDomain, BlockTypeMask, BlockTypeIP} {
+		if blockTypeName(blockType) == name {
+			return blockType, true
+		}
+	}
+
+	return 0, false
+}
+
+// splitList - comma separated list without empty items.
+func splitList(s string) []string {
+	var list []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportPrefixes(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	filter, err := newExportFilter("ip", "15_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v4, v6 := CurrentDump.exportPrefixes(filter)
+
+	var buf bytes.Buffer
+
+	writeCIDRList(&buf, v4, v6)
+
+	want := "10.3.3.3/32\n10.4.0.0/16\n192.168.0.100/32\n192.168.3.33/32\n192.168.4.44/32\n192.168.4.100/32\n" +
+		"fd33:3::3/128\nfd33:33::3/128\nfd44:4::1/128\nfd44:44::1/128\nfdaa:f::100/128\n"
+	if buf.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, buf.String())
+	}
+
+	all, _ := newExportFilter("", "")
+	if v4, _ := CurrentDump.exportPrefixes(all); len(v4) != 13 {
+		t.Errorf("Expected 13 IPv4 prefixes, got %d: %v", len(v4), v4)
+	}
+
+	none, _ := newExportFilter("", "15_3")
+	if v4, v6 := CurrentDump.exportPrefixes(none); len(v4)+len(v6) != 0 {
+		t.Errorf("Expected nothing, got %v %v", v4, v6)
+	}
+
+	if _, err := newExportFilter("ip,bad", ""); err == nil {
+		t.Errorf("Expected unknown block type error")
+	}
+
+	buf.Reset()
+	writeNftSets(&buf, "inet", "filter", "blocked", v4[:1], nil)
+
+	wantNft := "table inet filter {\n\tset blocked_v4 {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t\telements = {\n\t\t\t10.3.3.3/32\n\t\t}\n\t}\n" +
+		"\tset blocked_v6 {\n\t\ttype ipv6_addr\n\t\tflags interval\n\t}\n}\n"
+	if buf.String() != wantNft {
+		t.Errorf("Expected\n%s\ngot\n%s", wantNft, buf.String())
+	}
+
+	buf.Reset()
+	writeIPSetRestore(&buf, "blocked", nil, v6[:1])
+
+	wantIPSet := "create blocked_v4 hash:net family inet maxelem 65536 -exist\nflush blocked_v4\n" +
+		"create blocked_v6 hash:net family inet6 maxelem 65536 -exist\nflush blocked_v6\nadd blocked_v6 fd33:3::3/128\n"
+	if buf.String() != wantIPSet {
+		t.Errorf("Expected\n%s\ngot\n%s", wantIPSet, buf.String())
+	}
+}
@@ -3,11 +3,15 @@ package main
 import (
 	"archive/zip"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"time"
+
+	"golang.org/x/net/html/charset"
 
 	"github.com/usher2/u2ckdump/internal/logger"
 )
@@ -29,8 +33,14 @@ type DumpAnswer struct {
 var (
 	ErrNot200HTTPCode = errors.New("not 200 HTTP code")
 	ErrEmptyAnswer    = errors.New("empty answer")
+	ErrBadDumpFile    = errors.New("not a registry dump")
+	ErrArchiveOlder   = errors.New("archive is older than the current dump")
+	ErrDumpShrunk     = errors.New("dump lost too many records")
 )
 
+// MaxDumpShrink - the share of the current records the new dump may lose, 0 disables the check.
+var MaxDumpShrink = 0.5
+
 // GetLastDumpID - fetch last dump ID from "vigruzki".
 func GetLastDumpID(ts int64, u, key string) (*DumpAnswer, error) {
 	answer := make([]DumpAnswer, 0)
@@ -157,6 +167,8 @@ func DumpUnzip(src, filename string) error {
 
 	defer r.Close()
 
+	found := false
+
 	for _, f := range r.File {
 		// look over file list and handle this one
 		if f.Name != "dump.xml" {
@@ -186,9 +198,15 @@ func DumpUnzip(src, filename string) error {
 			return fmt.Errorf("write unzipped: %w", err)
 		}
 
+		found = true
+
 		break
 	}
 
+	if !found {
+		return ErrNoDumpInArchive
+	}
+
 	err = os.Rename(tmpfilename, filename)
 	if err != nil {
 		return fmt.Errorf("file rename: %w", err)
@@ -196,3 +214,125 @@ func DumpUnzip(src, filename string) error {
 
 	return nil
 }
+
+// CheckDumpFile - sanity check of the extracted dump before Parse purges the missing records:
+// the register root element, the well-formed XML to the end and the record count against the current dump.
+func CheckDumpFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("open dump file: %w", err)
+	}
+
+	defer f.Close()
+
+	decoder := newDumpDecoder(f)
+
+	if _, err := readRegister(decoder); err != nil {
+		return err
+	}
+
+	records := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrBadDumpFile, err)
+		}
+
+		if element, ok := token.(xml.StartElement); ok && element.Name.Local == "content" {
+			records++
+
+			if err := decoder.Skip(); err != nil {
+				return fmt.Errorf("%w: %w", ErrBadDumpFile, err)
+			}
+		}
+	}
+
+	CurrentDump.RLock()
+	current := len(CurrentDump.ContentIndex)
+	CurrentDump.RUnlock()
+
+	if MaxDumpShrink > 0 && float64(records) < float64(current)*(1-MaxDumpShrink) {
+		return fmt.Errorf("%w: %d records, the current dump has %d", ErrDumpShrunk, records, current)
+	}
+
+	return nil
+}
+
+// CheckArchiveTime - the archive is not older than the current dump, the older one rewinds the registry.
+func CheckArchiveTime(src string) error {
+	reg, err := ArchiveRegister(src)
+	if err != nil {
+		return err
+	}
+
+	CurrentDump.RLock()
+	utime := CurrentDump.utime
+	CurrentDump.RUnlock()
+
+	if reg.UpdateTime < utime {
+		return fmt.Errorf("%w: %s < %s", ErrArchiveOlder,
+			time.Unix(reg.UpdateTime, 0).Format(time.RFC3339), time.Unix(utime, 0).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// ArchiveRegister - the register root element of dump.xml in the archive, it is not extracted.
+func ArchiveRegister(src string) (*Reg, error) {
+	r, err := zip.OpenReader(src)
+	if err != nil {
+		return nil, fmt.Errorf("open zip arch: %w", err)
+	}
+
+	defer r.Close()
+
+	for _, f := range r.File {
+		if f.Name != "dump.xml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("open zipped file: %w", err)
+		}
+
+		defer rc.Close()
+
+		return readRegister(newDumpDecoder(rc))
+	}
+
+	return nil, ErrNoDumpInArchive
+}
+
+func newDumpDecoder(r io.Reader) *xml.Decoder {
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	return decoder
+}
+
+// readRegister - the register root element of the dump.
+func readRegister(decoder *xml.Decoder) (*Reg, error) {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBadDumpFile, err)
+		}
+
+		if element, ok := token.(xml.StartElement); ok {
+			if element.Name.Local != "register" {
+				return nil, fmt.Errorf("%w: root element %s", ErrBadDumpFile, element.Name.Local)
+			}
+
+			reg := &Reg{}
+			parseRegister(element, reg)
+
+			return reg, nil
+		}
+	}
+}
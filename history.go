@@ -0,0 +1,456 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrHistoryDisabled = errors.New("history is disabled")
+	ErrHistoryTooOld   = errors.New("no history at this time")
+)
+
+// historySnapshotsCached - how many point-in-time dumps to keep.
+const historySnapshotsCached = 4
+
+// RecordVersion - version of the record valid from Since till Until.
+type RecordVersion struct {
+	Since      int64  // registry update time of the dump the version appeared in.
+	Until      int64  // registry update time of the dump the version disappeared from, 0 if it is current.
+	RecordHash uint64 // hash of <content>...</content>.
+	Payload    []byte // protobuf ContentRecord.
+}
+
+// HistoryEntry - new version of the record.
+type HistoryEntry struct {
+	ID         int32
+	RecordHash uint64
+	Payload    []byte
+}
+
+// HistoryBatch - changes of the records applied by one dump. It is the change journal item.
+type HistoryBatch struct {
+	UpdateTime int64
+	Added      []HistoryEntry
+	Updated    []HistoryEntry
+	Removed    []int32
+}
+
+// History - versions of every record ever seen, optionally persisted to the append only file.
+// Committed batches are pending till flush writes them to the file.
+type History struct {
+	sync.RWMutex
+	Versions   map[int32][]*RecordVersion
+	Times      []int64 // sorted registry update times of applied dumps.
+	filename   string
+	snapshots  map[int64]*Dump
+	generation uint64 // changed by every applied batch, snapshots built before are not cached.
+	pending    []*HistoryBatch
+	fileMutex  sync.Mutex // keeps the order of the flushed batches.
+}
+
+func NewHistory(filename string) *History {
+	return &History{
+		Versions:  make(map[int32][]*RecordVersion),
+		filename:  filename,
+		snapshots: make(map[int64]*Dump),
+	}
+}
+
+// LoadHistory - replay the history file, missing file is an empty history.
+// The torn last frame of the interrupted append is cut off.
+func LoadHistory(filename string) (*History, error) {
+	h := NewHistory(filename)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return h, nil
+		}
+
+		return nil, fmt.Errorf("open history: %w", err)
+	}
+
+	r := &countingReader{r: bufio.NewReader(f)}
+
+	var offset int64 // end of the last good frame.
+
+	for {
+		batch, err := readHistoryBatch(r)
+		if err != nil {
+			f.Close()
+
+			switch {
+			case errors.Is(err, io.EOF):
+				return h, nil
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				logger.Warning.Printf("History file is torn at %d, truncated: %s\n", offset, err.Error())
+
+				if err := os.Truncate(filename, offset); err != nil {
+					return nil, fmt.Errorf("truncate history: %w", err)
+				}
+
+				return h, nil
+			}
+
+			return nil, fmt.Errorf("read history: %w", err)
+		}
+
+		h.apply(batch)
+
+		offset = r.n
+	}
+}
+
+// countingReader - counts the bytes read.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+
+	return n, err
+}
+
+// readHistoryBatch - read one length prefixed gob frame.
+func readHistoryBatch(r io.Reader) (*HistoryBatch, error) {
+	var size uint32
+
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF // the frame without the body is torn too.
+		}
+
+		return nil, fmt.Errorf("frame: %w", err)
+	}
+
+	batch := &HistoryBatch{}
+	if err := gob.NewDecoder(bytes.NewReader(buf)).Decode(batch); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	return batch, nil
+}
+
+// writeHistoryBatch - write one length prefixed gob frame.
+func writeHistoryBatch(w io.Writer, batch *HistoryBatch) error {
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(batch); err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	if err := binary.Write(w, binary.BigEndian, uint32(buf.Len())); err != nil {
+		return fmt.Errorf("frame: %w", err)
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("frame: %w", err)
+	}
+
+	return nil
+}
+
+// lastTime - registry update time of the last applied dump, 0 for the empty or the disabled history.
+func (h *History) lastTime() int64 {
+	if h == nil {
+		return 0
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+
+	if len(h.Times) == 0 {
+		return 0
+	}
+
+	return h.Times[len(h.Times)-1]
+}
+
+// current - open version of the record.
+func (h *History) current(id int32) *RecordVersion {
+	versions := h.Versions[id]
+	if len(versions) == 0 || versions[len(versions)-1].Until != 0 {
+		return nil
+	}
+
+	return versions[len(versions)-1]
+}
+
+// commit - diff the records with open versions, apply the batch and queue it for flush.
+func (h *History) commit(index PackedContentMap, utime int64) {
+	if h == nil {
+		return
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	// versions are valid only if dumps come in time order.
+	if len(h.Times) > 0 && utime < h.Times[len(h.Times)-1] {
+		logger.Warning.Printf("Dump is older than the history, not recorded: %d < %d\n", utime, h.Times[len(h.Times)-1])
+
+		return
+	}
+
+	batch := &HistoryBatch{UpdateTime: utime}
+
+	for id, cont := range index {
+		last := h.current(id)
+
+		switch {
+		case last == nil:
+			batch.Added = append(batch.Added, HistoryEntry{ID: id, RecordHash: cont.RecordHash, Payload: cont.Payload})
+		case last.RecordHash != cont.RecordHash:
+			batch.Updated = append(batch.Updated, HistoryEntry{ID: id, RecordHash: cont.RecordHash, Payload: cont.Payload})
+		}
+	}
+
+	for id := range h.Versions {
+		if _, ok := index[id]; !ok && h.current(id) != nil {
+			batch.Removed = append(batch.Removed, id)
+		}
+	}
+
+	if len(batch.Added)+len(batch.Updated)+len(batch.Removed) == 0 &&
+		len(h.Times) > 0 && h.Times[len(h.Times)-1] == utime {
+		return
+	}
+
+	h.apply(batch)
+
+	if h.filename != "" {
+		h.pending = append(h.pending, batch)
+	}
+}
+
+// flush - appends the committed batches to the history file, it is called without the dump lock.
+func (h *History) flush() {
+	if h == nil {
+		return
+	}
+
+	h.fileMutex.Lock()
+	defer h.fileMutex.Unlock()
+
+	h.Lock()
+	batches := h.pending
+	h.pending = nil
+	h.Unlock()
+
+	for _, batch := range batches {
+		if err := h.append(batch); err != nil {
+			logger.Error.Printf("Can't save history: %s\n", err.Error())
+		}
+	}
+}
+
+// apply - apply the batch to versions.
+func (h *History) apply(batch *HistoryBatch) {
+	for _, entry := range append(batch.Added, batch.Updated...) {
+		if last := h.current(entry.ID); last != nil {
+			last.Until = batch.UpdateTime
+		}
+
+		h.Versions[entry.ID] = append(h.Versions[entry.ID], &RecordVersion{
+			Since:      batch.UpdateTime,
+			RecordHash: entry.RecordHash,
+			Payload:    entry.Payload,
+		})
+	}
+
+	for _, id := range batch.Removed {
+		if last := h.current(id); last != nil {
+			last.Until = batch.UpdateTime
+		}
+	}
+
+	i := sort.Search(len(h.Times), func(i int) bool { return h.Times[i] >= batch.UpdateTime })
+	if i == len(h.Times) || h.Times[i] != batch.UpdateTime {
+		h.Times = append(h.Times, 0)
+		copy(h.Times[i+1:], h.Times[i:])
+		h.Times[i] = batch.UpdateTime
+	}
+
+	clear(h.snapshots)
+	h.generation++
+}
+
+// append - append the batch to the history file.
+func (h *History) append(batch *HistoryBatch) error {
+	if h.filename == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(h.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+
+	w := bufio.NewWriter(f)
+
+	if err := writeHistoryBatch(w, batch); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return f.Close()
+}
+
+// Snapshot - reconstructed dump as it was at asOf. The dump is read only.
+// The versions are picked under the read lock, the dump is built without the lock,
+// so the slow snapshot doesn't hold commit and the dump lock behind it.
+func (h *History) Snapshot(asOf int64) (*Dump, error) {
+	if h == nil {
+		return nil, ErrHistoryDisabled
+	}
+
+	utime, payloads, generation, cached, err := h.snapshotVersions(asOf)
+	if err != nil || cached != nil {
+		return cached, err
+	}
+
+	dump := newDump()
+
+	for _, payload := range payloads {
+		rec := &pb.ContentRecord{}
+		if err := proto.Unmarshal(payload, rec); err != nil {
+			logger.Error.Printf("Can't decode history record: %s\n", err.Error())
+
+			continue
+		}
+
+		record := newContentFromPbRecord(rec)
+		record.HTTPSBlock = 0 // it is counted again.
+
+		dump.NewPackedContent(record, utime)
+	}
+
+	dump.utime = utime
+	dump.generation = uint64(utime)
+	dump.buildTimeIndexes()
+	dump.buildPackedOrgIndex()
+
+	h.Lock()
+	defer h.Unlock()
+
+	if h.generation == generation {
+		if len(h.snapshots) >= historySnapshotsCached {
+			clear(h.snapshots)
+		}
+
+		h.snapshots[utime] = dump
+	}
+
+	return dump, nil
+}
+
+// snapshotVersions - the dump time at asOf and the payloads of the versions valid then in content ID order,
+// or the cached snapshot.
+func (h *History) snapshotVersions(asOf int64) (int64, [][]byte, uint64, *Dump, error) {
+	h.RLock()
+	defer h.RUnlock()
+
+	i := sort.Search(len(h.Times), func(i int) bool { return h.Times[i] > asOf }) - 1
+	if i < 0 {
+		return 0, nil, 0, nil, ErrHistoryTooOld
+	}
+
+	utime := h.Times[i]
+
+	if dump, ok := h.snapshots[utime]; ok {
+		return utime, nil, h.generation, dump, nil
+	}
+
+	// stable order of index arrays.
+	ids := make([]int32, 0, len(h.Versions))
+	for id := range h.Versions {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	payloads := make([][]byte, 0, len(ids))
+
+	for _, id := range ids {
+		for _, v := range h.Versions[id] {
+			if v.Since > utime || (v.Until != 0 && v.Until <= utime) {
+				continue
+			}
+
+			payloads = append(payloads, v.Payload)
+
+			break
+		}
+	}
+
+	return utime, payloads, h.generation, nil, nil
+}
+
+// RecordVersions - versions of the record with changes against the previous version, oldest first.
+func (h *History) RecordVersions(id int32) ([]*pb.RecordVersion, error) {
+	if h == nil {
+		return nil, ErrHistoryDisabled
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+
+	versions := h.Versions[id]
+	res := make([]*pb.RecordVersion, 0, len(versions))
+
+	var prev *pb.ContentRecord
+
+	for i, v := range versions {
+		rec := &pb.ContentRecord{}
+		if err := proto.Unmarshal(v.Payload, rec); err != nil {
+			return nil, fmt.Errorf("decode history record: %w", err)
+		}
+
+		version := &pb.RecordVersion{
+			Since:      v.Since,
+			Until:      v.Until,
+			RecordHash: v.RecordHash,
+			Record:     rec,
+		}
+
+		if prev != nil {
+			version.Diff = diffRecords(prev, rec)
+			version.Diff.Reappeared = versions[i-1].Until != v.Since
+		}
+
+		res = append(res, version)
+		prev = rec
+	}
+
+	return res, nil
+}
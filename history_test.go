@@ -0,0 +1,201 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHistorySnapshot(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "history")
+
+	CurrentDump = NewDump()
+	CurrentDump.history = NewHistory(filename)
+
+	t1 := "2011-01-01T01:01:01+03:00"
+	t2 := "2011-01-02T01:01:01+03:00"
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Parse(strings.NewReader(dumpWithout(t2, "555"))); err != nil {
+		t.Fatal(err)
+	}
+
+	// the same dump again changes nothing.
+	if err := Parse(strings.NewReader(dumpWithout(t2, "555"))); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadHistory(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(loaded.Times) != 2 || len(loaded.Versions) != 5 || len(loaded.Versions[555]) != 1 {
+		t.Fatalf("Loaded history error: %v %d", loaded.Times, len(loaded.Versions))
+	}
+
+	tests := []struct {
+		name string
+		asOf int64
+		want []int32
+		err  error
+	}{
+		{"before history", parseRFC3339Time(t1) - 1, nil, ErrHistoryTooOld},
+		{"first dump", parseRFC3339Time(t1), []int32{222, 555}, nil},
+		{"between dumps", parseRFC3339Time(t2) - 1, []int32{222, 555}, nil},
+		{"second dump", parseRFC3339Time(t2), []int32{222}, nil},
+		{"later", parseRFC3339Time(t2) + 3600, []int32{222}, nil},
+	}
+
+	for _, h := range []*History{CurrentDump.history, loaded} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				dump, err := h.Snapshot(tt.asOf)
+				if err != tt.err {
+					t.Fatalf("Expected error %v, got %v", tt.err, err)
+				}
+
+				if err != nil {
+					return
+				}
+
+				got := dump.searchDomain("www.e02.tld")
+				if len(got) != len(tt.want) {
+					t.Fatalf("Expected %v, got %v", tt.want, got)
+				}
+
+				for i := range got {
+					if got[i].ID != tt.want[i] {
+						t.Errorf("Expected %v, got %v", tt.want, got)
+					}
+				}
+
+				if len(dump.searchIPv4(IPv4StrToInt("10.4.1.1"))) != 1 {
+					t.Errorf("Expected subnet in snapshot")
+				}
+			})
+		}
+	}
+}
+
+func TestHistoryFlush(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "history")
+
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the batch is written by flush, not under the dump lock of commit.
+	h := NewHistory(filename)
+	h.commit(CurrentDump.ContentIndex, CurrentDump.utime)
+
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		t.Fatalf("Expected no history file before flush, got %v", err)
+	}
+
+	h.flush()
+
+	loaded, err := LoadHistory(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(loaded.Times) != 1 || len(loaded.Versions) != len(CurrentDump.ContentIndex) {
+		t.Errorf("Expected 1 dump of %d records, got %v %d", len(CurrentDump.ContentIndex), loaded.Times, len(loaded.Versions))
+	}
+}
+
+func TestLoadHistoryTorn(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "history")
+
+	CurrentDump = NewDump()
+	CurrentDump.history = NewHistory(filename)
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	good, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the crash in the size, in the body and right after the size.
+	for _, torn := range [][]byte{{0, 0}, {0, 0, 0, 100, 1, 2, 3}, {0, 0, 0, 100}} {
+		if err := os.WriteFile(filename, append(append([]byte{}, good...), torn...), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		h, err := LoadHistory(filename)
+		if err != nil {
+			t.Fatalf("%v: Expected nil, got %v", torn, err)
+		}
+
+		if len(h.Times) != 1 {
+			t.Errorf("%v: Expected 1 dump, got %v", torn, h.Times)
+		}
+
+		if fi, _ := os.Stat(filename); fi.Size() != int64(len(good)) {
+			t.Errorf("%v: Expected %d bytes, got %d", torn, len(good), fi.Size())
+		}
+	}
+}
+
+func TestRecordVersions(t *testing.T) {
+	CurrentDump = NewDump()
+	CurrentDump.history = NewHistory("")
+
+	changed := strings.NewReplacer(
+		`updateTime="2011-01-01T01:01:01+03:00"`, `updateTime="2011-01-02T01:01:01+03:00"`,
+		`<ip>10.2.2.2</ip>`, `<ip>10.2.2.3</ip>`,
+		`org="TWO"`, `org="TWO-TWO"`,
+	).Replace(xml01)
+
+	for _, dump := range []string{
+		xml01,
+		changed,
+		dumpWithout("2011-01-03T01:01:01+03:00", "222"),
+		strings.Replace(changed, "2011-01-02T", "2011-01-04T", 1),
+	} {
+		if err := Parse(strings.NewReader(dump)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, err := CurrentDump.history.RecordVersions(222)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(versions))
+	}
+
+	if versions[0].Diff != nil || versions[0].Until != parseRFC3339Time("2011-01-02T01:01:01+03:00") {
+		t.Errorf("First version error: %v", versions[0])
+	}
+
+	diff := versions[1].Diff
+	if len(diff.Ip4Added) != 1 || diff.Ip4Added[0] != "10.2.2.3" || len(diff.Ip4Removed) != 1 || diff.Ip4Removed[0] != "10.2.2.2" {
+		t.Errorf("Expected 10.2.2.2 -> 10.2.2.3, got %v %v", diff.Ip4Added, diff.Ip4Removed)
+	}
+
+	if !diff.DecisionChanged || diff.PrevDecision.GetOrg() != "TWO" || diff.EntryTypeChanged || diff.Reappeared {
+		t.Errorf("Second version diff error: %v", diff)
+	}
+
+	diff = versions[2].Diff
+	if !diff.Reappeared || diff.DecisionChanged || len(diff.Ip4Added)+len(diff.Ip4Removed) != 0 {
+		t.Errorf("Third version diff error: %v", diff)
+	}
+
+	if versions[2].Until != 0 {
+		t.Errorf("Expected the current version, got until %d", versions[2].Until)
+	}
+}
@@ -0,0 +1,478 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// ICAP defaults.
+const (
+	icapService     = "u2ckdump"
+	icapIdleTimeout = 5 * time.Minute
+	icapMaxHeader   = 64 << 10
+	icapMaxBody     = 1 << 20 // the body is only echoed back, it is not checked.
+)
+
+// Errors
+var (
+	ErrICAPBadRequest    = errors.New("bad icap request")
+	ErrICAPBadEncap      = errors.New("bad encapsulated header")
+	ErrICAPBadChunk      = errors.New("bad chunk")
+	ErrICAPHeaderTooLong = errors.New("encapsulated header too long")
+	ErrICAPBodyTooLong   = errors.New("encapsulated body too long")
+)
+
+// icapDefaultPage - block page, it is executed with icapPage.
+const icapDefaultPage = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Доступ ограничен</title></head>
+<body>
+<h1>Доступ ограничен</h1>
+<p>{{.URL}}</p>
+<ul>
+{{range .Blocks}}<li>#{{.ID}} {{.Org}} {{.Number}} {{.Date}} ({{.EntryType}})</li>
+{{end}}</ul>
+</body>
+</html>
+`
+
+// icapPage - block page data.
+type icapPage struct {
+	URL    string
+	Blocks []icapBlock
+}
+
+// icapBlock - content blocking the request.
+type icapBlock struct {
+	ID        int32
+	Org       string
+	Number    string
+	Date      string
+	EntryType string
+}
+
+// ICAPServer - RFC 3507 REQMOD service checking requests against the current dump.
+// Blocked requests get the block page, others get 204.
+type ICAPServer struct {
+	listener net.Listener
+	page     *template.Template
+}
+
+// ListenICAP - listen TCP on the address, empty page file is the default block page.
+func ListenICAP(addr, pageFile string) (*ICAPServer, error) {
+	text := icapDefaultPage
+
+	if pageFile != "" {
+		b, err := os.ReadFile(pageFile)
+		if err != nil {
+			return nil, fmt.Errorf("read block page: %w", err)
+		}
+
+		text = string(b)
+	}
+
+	page, err := template.New("page").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse block page: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen: %w", err)
+	}
+
+	return &ICAPServer{listener: listener, page: page}, nil
+}
+
+// Serve - accept connections until Close.
+func (s *ICAPServer) Serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			logger.Error.Printf("ICAP accept: %s\n", err.Error())
+
+			continue
+		}
+
+		go s.serveConn(conn)
+	}
+}
+
+// Close - stop listening.
+func (s *ICAPServer) Close() {
+	s.listener.Close()
+}
+
+// icapRequest - parsed ICAP request.
+type icapRequest struct {
+	Method  string
+	Header  textproto.MIMEHeader
+	Encap   map[string]int
+	ReqHdr  []byte
+	Body    []byte
+	HasBody bool
+	IEOF    bool // the whole body is in the preview.
+}
+
+func (s *ICAPServer) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(icapIdleTimeout))
+
+		req, err := readICAPRequest(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				logger.Debug.Printf("ICAP read: %s\n", err.Error())
+
+				if errors.Is(err, ErrICAPBodyTooLong) {
+					writeICAPStatus(w, 413, "Request Entity Too Large")
+				} else {
+					writeICAPStatus(w, 400, "Bad Request")
+				}
+
+				w.Flush()
+			}
+
+			return
+		}
+
+		if err := s.respond(r, w, req); err != nil {
+			logger.Debug.Printf("ICAP respond: %s\n", err.Error())
+
+			return
+		}
+
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		if strings.EqualFold(req.Header.Get("Connection"), "close") {
+			return
+		}
+	}
+}
+
+// readICAPRequest - request line, ICAP headers, encapsulated HTTP headers and the body or its preview.
+func readICAPRequest(r *bufio.Reader) (*icapRequest, error) {
+	tp := textproto.NewReader(r)
+
+	line, err := tp.ReadLine()
+	if err != nil {
+		return nil, err
+	}
+
+	method, rest, ok := strings.Cut(line, " ")
+	if !ok || !strings.HasSuffix(rest, "ICAP/1.0") {
+		return nil, fmt.Errorf("%w: %s", ErrICAPBadRequest, line)
+	}
+
+	header, err := tp.ReadMIMEHeader()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrICAPBadRequest, err)
+	}
+
+	req := &icapRequest{Method: method, Header: header}
+
+	if req.Encap, err = parseEncapsulated(header.Get("Encapsulated")); err != nil {
+		return nil, err
+	}
+
+	if offset, ok := req.Encap["req-hdr"]; ok {
+		end, ok := req.Encap["req-body"]
+		if !ok {
+			end, ok = req.Encap["null-body"]
+		}
+
+		if !ok || end < offset {
+			return nil, ErrICAPBadEncap
+		}
+
+		if end-offset > icapMaxHeader {
+			return nil, ErrICAPHeaderTooLong
+		}
+
+		req.ReqHdr = make([]byte, end-offset)
+		if _, err := io.ReadFull(r, req.ReqHdr); err != nil {
+			return nil, fmt.Errorf("read req-hdr: %w", err)
+		}
+	}
+
+	if _, ok := req.Encap["req-body"]; ok {
+		req.HasBody = true
+
+		if req.Body, req.IEOF, err = readICAPChunks(r, icapMaxBody); err != nil {
+			return nil, err
+		}
+
+		// without preview the whole body is sent.
+		if header.Get("Preview") == "" {
+			req.IEOF = true
+		}
+	}
+
+	return req, nil
+}
+
+// parseEncapsulated - "req-hdr=0, req-body=412" into the map.
+func parseEncapsulated(s string) (map[string]int, error) {
+	encap := make(map[string]int)
+
+	for _, item := range splitList(s) {
+		name, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrICAPBadEncap, s)
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrICAPBadEncap, s)
+		}
+
+		encap[name] = n
+	}
+
+	return encap, nil
+}
+
+// readICAPChunks - chunked body till the zero chunk, ieof is the zero chunk extension.
+// The body over limit is an error before the chunk is read.
+func readICAPChunks(r *bufio.Reader, limit int) ([]byte, bool, error) {
+	var body []byte
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %w", ErrICAPBadChunk, err)
+		}
+
+		sizeStr, ext, _ := strings.Cut(strings.TrimRight(line, "\r\n"), ";")
+
+		size, err := strconv.ParseInt(strings.TrimSpace(sizeStr), 16, 32)
+		if err != nil || size < 0 {
+			return nil, false, fmt.Errorf("%w: %q", ErrICAPBadChunk, line)
+		}
+
+		if size == 0 {
+			// trailing CRLF.
+			if _, err := r.ReadString('\n'); err != nil {
+				return nil, false, fmt.Errorf("%w: %w", ErrICAPBadChunk, err)
+			}
+
+			return body, strings.TrimSpace(ext) == "ieof", nil
+		}
+
+		if size > int64(limit-len(body)) {
+			return nil, false, fmt.Errorf("%w: over %d bytes", ErrICAPBodyTooLong, limit)
+		}
+
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return nil, false, fmt.Errorf("%w: %w", ErrICAPBadChunk, err)
+		}
+
+		body = append(body, chunk[:size]...)
+	}
+}
+
+func (s *ICAPServer) respond(r *bufio.Reader, w *bufio.Writer, req *icapRequest) error {
+	switch req.Method {
+	case "OPTIONS":
+		writeICAPOptions(w)
+
+		return nil
+	case "REQMOD":
+	default:
+		writeICAPStatus(w, 405, "Method Not Allowed")
+
+		return nil
+	}
+
+	if req.ReqHdr == nil {
+		writeICAPStatus(w, 400, "Bad Request")
+
+		return nil
+	}
+
+	httpReq, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(req.ReqHdr)))
+	if err != nil {
+		writeICAPStatus(w, 400, "Bad Request")
+
+		return nil
+	}
+
+	u := icapRequestURL(httpReq)
+
+	if page := s.check(u); page != nil {
+		return s.writeBlockPage(w, page)
+	}
+
+	if allow204(req.Header) {
+		writeICAPStatus(w, 204, "No Content")
+
+		return nil
+	}
+
+	// 204 is not allowed: the request is returned unmodified.
+	if req.HasBody && !req.IEOF {
+		w.WriteString("ICAP/1.0 100 Continue\r\n\r\n")
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		rest, _, err := readICAPChunks(r, icapMaxBody-len(req.Body))
+		if err != nil {
+			if errors.Is(err, ErrICAPBodyTooLong) {
+				writeICAPStatus(w, 413, "Request Entity Too Large")
+				w.Flush()
+			}
+
+			return err
+		}
+
+		req.Body = append(req.Body, rest...)
+	}
+
+	bodyKey := "null-body"
+	if req.HasBody {
+		bodyKey = "req-body"
+	}
+
+	fmt.Fprintf(w, "ICAP/1.0 200 OK\r\nISTag: %s\r\nEncapsulated: req-hdr=0, %s=%d\r\n\r\n", icapISTag(), bodyKey, len(req.ReqHdr))
+	w.Write(req.ReqHdr)
+
+	if req.HasBody {
+		writeICAPChunk(w, req.Body)
+	}
+
+	return nil
+}
+
+func allow204(header textproto.MIMEHeader) bool {
+	for _, v := range splitList(header.Get("Allow")) {
+		if v == "204" {
+			return true
+		}
+	}
+
+	// the preview may always be answered with 204.
+	return header.Get("Preview") != ""
+}
+
+// icapRequestURL - absolute URL of the encapsulated request.
+func icapRequestURL(req *http.Request) string {
+	switch {
+	case req.Method == http.MethodConnect:
+		return req.Host
+	case req.URL.IsAbs():
+		return req.URL.String()
+	default:
+		return "http://" + req.Host + req.URL.RequestURI()
+	}
+}
+
+// check - block page data if the URL or its host is blocked.
+func (s *ICAPServer) check(u string) *icapPage {
+	if CurrentDump == nil {
+		return nil
+	}
+
+	CurrentDump.RLock()
+	defer CurrentDump.RUnlock()
+
+	matches := CurrentDump.searchResource(u)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	page := &icapPage{URL: u}
+
+	for _, m := range matches {
+		cont, ok := CurrentDump.ContentIndex[m.ID]
+		if !ok {
+			continue
+		}
+
+		rec := cont.Record()
+
+		page.Blocks = append(page.Blocks, icapBlock{
+			ID:        m.ID,
+			Org:       rec.GetDecision().GetOrg(),
+			Number:    rec.GetDecision().GetNumber(),
+			Date:      rec.GetDecision().GetDate(),
+			EntryType: cont.EntryTypeString,
+		})
+	}
+
+	return page
+}
+
+func (s *ICAPServer) writeBlockPage(w *bufio.Writer, page *icapPage) error {
+	var body bytes.Buffer
+
+	if err := s.page.Execute(&body, page); err != nil {
+		return fmt.Errorf("block page: %w", err)
+	}
+
+	hdr := fmt.Sprintf("HTTP/1.1 403 Forbidden\r\nContent-Type: text/html; charset=utf-8\r\nContent-Length: %d\r\nCache-Control: no-store\r\nConnection: close\r\n\r\n", body.Len())
+
+	fmt.Fprintf(w, "ICAP/1.0 200 OK\r\nISTag: %s\r\nEncapsulated: res-hdr=0, res-body=%d\r\n\r\n", icapISTag(), len(hdr))
+	w.WriteString(hdr)
+	writeICAPChunk(w, body.Bytes())
+
+	return nil
+}
+
+// writeICAPChunk - the body as one chunk and the zero chunk.
+func writeICAPChunk(w *bufio.Writer, body []byte) {
+	if len(body) > 0 {
+		fmt.Fprintf(w, "%x\r\n", len(body))
+		w.Write(body)
+		w.WriteString("\r\n")
+	}
+
+	w.WriteString("0\r\n\r\n")
+}
+
+func writeICAPStatus(w *bufio.Writer, code int, reason string) {
+	fmt.Fprintf(w, "ICAP/1.0 %d %s\r\nISTag: %s\r\nEncapsulated: null-body=0\r\n\r\n", code, reason, icapISTag())
+}
+
+func writeICAPOptions(w *bufio.Writer) {
+	fmt.Fprintf(w, "ICAP/1.0 200 OK\r\nMethods: REQMOD\r\nService: %s\r\nISTag: %s\r\nAllow: 204\r\nPreview: 0\r\nTransfer-Preview: *\r\nOptions-TTL: 3600\r\nEncapsulated: null-body=0\r\n\r\n",
+		icapService, icapISTag())
+}
+
+// icapISTag - the service state tag, it changes with the dump.
+func icapISTag() string {
+	var utime int64
+
+	if CurrentDump != nil {
+		CurrentDump.RLock()
+		utime = CurrentDump.utime
+		CurrentDump.RUnlock()
+	}
+
+	return fmt.Sprintf(`"%s-%d"`, icapService, utime)
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// icapResponse - status, encapsulated HTTP headers and body of the ICAP response.
+type icapResponse struct {
+	Status int
+	Header textproto.MIMEHeader
+	HTTP   string
+	Body   string
+}
+
+// icapRoundTrip - local ICAP client: writes the raw request and reads the response.
+func icapRoundTrip(t *testing.T, conn net.Conn, r *bufio.Reader, raw string) *icapResponse {
+	t.Helper()
+
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	if _, err := io.WriteString(conn, raw); err != nil {
+		t.Fatal(err)
+	}
+
+	tp := textproto.NewReader(r)
+
+	line, err := tp.ReadLine()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &icapResponse{}
+	if _, err := fmt.Sscanf(line, "ICAP/1.0 %d", &resp.Status); err != nil {
+		t.Fatalf("Bad status line: %q", line)
+	}
+
+	if resp.Header, err = tp.ReadMIMEHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	encap, err := parseEncapsulated(resp.Header.Get("Encapsulated"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var size int
+
+	for _, key := range []string{"res-body", "req-body", "null-body"} {
+		if n, ok := encap[key]; ok {
+			size = n
+		}
+	}
+
+	hdr := make([]byte, size)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		t.Fatal(err)
+	}
+
+	resp.HTTP = string(hdr)
+
+	if _, ok := encap["null-body"]; !ok {
+		body, _, err := readICAPChunks(r, icapMaxBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp.Body = string(body)
+	}
+
+	return resp
+}
+
+// icapReqmod - REQMOD request with the HTTP headers and the optional body.
+func icapReqmod(httpHdr, body, extra string) string {
+	if body == "" {
+		return fmt.Sprintf("REQMOD icap://127.0.0.1/reqmod ICAP/1.0\r\nHost: 127.0.0.1\r\n%sEncapsulated: req-hdr=0, null-body=%d\r\n\r\n%s",
+			extra, len(httpHdr), httpHdr)
+	}
+
+	return fmt.Sprintf("REQMOD icap://127.0.0.1/reqmod ICAP/1.0\r\nHost: 127.0.0.1\r\n%sEncapsulated: req-hdr=0, req-body=%d\r\n\r\n%s%x\r\n%s\r\n0\r\n\r\n",
+		extra, len(httpHdr), httpHdr, len(body), body)
+}
+
+func TestICAP(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := ListenICAP("127.0.0.1:0", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer s.Close()
+
+	go s.Serve()
+
+	conn, err := net.Dial("tcp", s.listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+
+	t.Run("options", func(t *testing.T) {
+		resp := icapRoundTrip(t, conn, r, "OPTIONS icap://127.0.0.1/reqmod ICAP/1.0\r\nHost: 127.0.0.1\r\n\r\n")
+
+		if resp.Status != 200 || resp.Header.Get("Methods") != "REQMOD" {
+			t.Errorf("Expected 200 REQMOD, got %d %q", resp.Status, resp.Header.Get("Methods"))
+		}
+
+		if resp.Header.Get("ISTag") != `"u2ckdump-1293832861"` {
+			t.Errorf("Expected ISTag of the dump, got %s", resp.Header.Get("ISTag"))
+		}
+	})
+
+	// all requests go over the same connection.
+	tests := []struct {
+		name   string
+		req    string
+		status int
+		want   []string
+	}{
+		{"url", icapReqmod("GET http://www.e01.tld/cheese HTTP/1.1\r\nHost: www.e01.tld\r\n\r\n", "", "Allow: 204\r\n"),
+			200, []string{"HTTP/1.1 403 Forbidden", "#111 ONE 1/1/11-1111 2000-01-01"}},
+		{"origin form", icapReqmod("GET /any HTTP/1.1\r\nHost: WWW.e02.tld\r\n\r\n", "", "Allow: 204\r\n"),
+			200, []string{"#222 TWO 2/2/22-2222", "#555 FIVE"}},
+		{"connect subnet", icapReqmod("CONNECT 10.4.1.1:443 HTTP/1.1\r\nHost: 10.4.1.1:443\r\n\r\n", "", "Allow: 204\r\n"),
+			200, []string{"#444 FOUR 4/4/44-4444"}},
+		{"not blocked", icapReqmod("GET http://example.com/ HTTP/1.1\r\nHost: example.com\r\n\r\n", "", "Allow: 204\r\n"),
+			204, nil},
+		{"preview", "REQMOD icap://127.0.0.1/reqmod ICAP/1.0\r\nHost: 127.0.0.1\r\nPreview: 0\r\nEncapsulated: req-hdr=0, req-body=60\r\n\r\n" +
+			"POST http://example.com/form HTTP/1.1\r\nHost: example.com\r\n\r\n0\r\n\r\n",
+			204, nil},
+		{"no 204", icapReqmod("POST http://example.com/form HTTP/1.1\r\nHost: example.com\r\nContent-Length: 3\r\n\r\n", "abc", ""),
+			200, []string{"POST http://example.com/form HTTP/1.1", "abc"}},
+		{"bad method", "RESPMOD icap://127.0.0.1/respmod ICAP/1.0\r\nHost: 127.0.0.1\r\nEncapsulated: null-body=0\r\n\r\n",
+			405, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := icapRoundTrip(t, conn, r, tt.req)
+
+			if resp.Status != tt.status {
+				t.Fatalf("Expected %d, got %d", tt.status, resp.Status)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(resp.HTTP+resp.Body, want) {
+					t.Errorf("Expected %q in %q", want, resp.HTTP+resp.Body)
+				}
+			}
+		})
+	}
+
+	// the oversized chunk is refused before it is read, the connection is closed.
+	t.Run("oversized chunk", func(t *testing.T) {
+		conn, err := net.Dial("tcp", s.listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer conn.Close()
+
+		httpHdr := "POST http://example.com/form HTTP/1.1\r\nHost: example.com\r\n\r\n"
+		req := fmt.Sprintf("REQMOD icap://127.0.0.1/reqmod ICAP/1.0\r\nHost: 127.0.0.1\r\nEncapsulated: req-hdr=0, req-body=%d\r\n\r\n%s7fffffff\r\n",
+			len(httpHdr), httpHdr)
+
+		if resp := icapRoundTrip(t, conn, bufio.NewReader(conn), req); resp.Status != 413 {
+			t.Errorf("Expected 413, got %d", resp.Status)
+		}
+	})
+}
+
+func TestICAPRequestURL(t *testing.T) {
+	CurrentDump = NewDump()
+
+	masked := strings.Replace(xml01, `blockType="domain" hash="PPPP"`, `blockType="domain-mask" hash="PPPP"`, 1)
+	if err := Parse(strings.NewReader(masked)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		u    string
+		want []int32
+	}{
+		{"http://a.b.www.e02.tld/", []int32{555}},
+		{"https://www.e01.tld/sex", []int32{111}},
+		{"http://[fd44:4::1]:8080/", []int32{444}},
+		{"www.e02.tld:443", []int32{222, 555}},
+		{"http://example.com/", nil},
+	}
+
+	for _, tt := range tests {
+		CurrentDump.RLock()
+		matches := CurrentDump.searchResource(tt.u)
+		CurrentDump.RUnlock()
+
+		var got []int32
+		for _, m := range matches {
+			got = append(got, m.ID)
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s: Expected %v, got %v", tt.u, tt.want, got)
+		}
+	}
+}
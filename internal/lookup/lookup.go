@@ -0,0 +1,93 @@
+// Package lookup - index keys and the check query classification shared by the service and the client.
+package lookup
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/normalize"
+)
+
+// Errors
+var (
+	ErrBadQuery = errors.New("bad query")
+)
+
+// Hash - FNV-1a hash of the string, the org index key.
+func Hash(s string) uint64 {
+	h64 := fnv.New64a()
+	h64.Write([]byte(s))
+
+	return h64.Sum64()
+}
+
+// DecisionHash - the decision index key.
+func DecisionHash(org, number, date string) uint64 {
+	// own hasher, it is used concurrently.
+	h64 := fnv.New64a()
+	h64.Write([]byte(org))
+	h64.Write([]byte(" "))
+	h64.Write([]byte(number))
+	h64.Write([]byte(" "))
+	h64.Write([]byte(date))
+
+	return h64.Sum64()
+}
+
+// Decision - the decision hash or "org|number|date" hashed as the server does.
+func Decision(query string) (uint64, error) {
+	if hash, err := strconv.ParseUint(query, 10, 64); err == nil {
+		return hash, nil
+	}
+
+	parts := strings.Split(query, "|")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("%w: want hash or org|number|date", ErrBadQuery)
+	}
+
+	return DecisionHash(parts[0], parts[1], parts[2]), nil
+}
+
+// Resource - the check query: the normalized URL and its host, the domain or the address.
+type Resource struct {
+	URL    string // normalized URL, empty if the query is not a URL.
+	Domain string // normalized domain, empty if the host is an address.
+	IP     net.IP // the host address, IPv4 is 4 bytes long.
+}
+
+// ParseResource - the URL, host:port, domain or address, URLs are searched by the host too.
+func ParseResource(query string) (Resource, error) {
+	var (
+		r    Resource
+		host = query
+	)
+
+	if strings.Contains(query, "://") {
+		u, err := url.Parse(query)
+		if err != nil {
+			return r, fmt.Errorf("%w: %w", ErrBadQuery, err)
+		}
+
+		r.URL, host = normalize.URL(query), u.Hostname()
+	} else if hostname, _, err := net.SplitHostPort(query); err == nil {
+		host = hostname // CONNECT host:port.
+	}
+
+	host = strings.Trim(host, "[]")
+
+	switch ip := net.ParseIP(host); {
+	case ip == nil:
+		r.Domain = normalize.Domain(host)
+	case ip.To4() != nil:
+		r.IP = ip.To4()
+	default:
+		r.IP = ip.To16()
+	}
+
+	return r, nil
+}
@@ -0,0 +1,70 @@
+package lookup
+
+import (
+	"io"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+func init() {
+	logger.LogInit(io.Discard, os.Stdout, os.Stderr, os.Stderr)
+}
+
+func TestDecision(t *testing.T) {
+	tests := []struct {
+		query string
+		want  uint64
+		err   bool
+	}{
+		{"12345", 12345, false},
+		{"ONE|1/1/11-1111|2000-01-01", Hash("ONE 1/1/11-1111 2000-01-01"), false},
+		{"ONE|1/1/11-1111", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Decision(tt.query)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: Expected error %v, got %v", tt.query, tt.err, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: Expected %d, got %d", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		query  string
+		url    string
+		domain string
+		ip     net.IP
+	}{
+		{"http://Example.COM/path#frag", "http://example.com/path", "example.com", nil},
+		{"https://1.2.3.4:8443/", "https://1.2.3.4:8443/", "", net.IPv4(1, 2, 3, 4).To4()},
+		{"example.com:443", "", "example.com", nil},
+		{"www.example.com", "", "www.example.com", nil},
+		{"10.0.0.1", "", "", net.IPv4(10, 0, 0, 1).To4()},
+		{"[fd00::1]:443", "", "", net.ParseIP("fd00::1")},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseResource(tt.query)
+		if err != nil {
+			t.Errorf("%s: Unexpected error %v", tt.query, err)
+
+			continue
+		}
+
+		if r.URL != tt.url || r.Domain != tt.domain || !r.IP.Equal(tt.ip) || len(r.IP) != len(tt.ip) {
+			t.Errorf("%s: Expected %q %q %v, got %q %q %v", tt.query, tt.url, tt.domain, tt.ip, r.URL, r.Domain, r.IP)
+		}
+	}
+
+	if _, err := ParseResource("http://%zz"); err == nil {
+		t.Errorf("Expected error of the bad URL")
+	}
+}
@@ -1,4 +1,5 @@
-package main
+// Package normalize - domain and URL normalizers shared by the service and the client.
+package normalize
 
 import (
 	"net/url"
@@ -10,12 +11,12 @@ import (
 	"github.com/usher2/u2ckdump/internal/logger"
 )
 
-// NormalizeDomain takes a domain name string containing misprints and
+// Domain takes a domain name string containing misprints and
 // attempts to construct the correct domain name. It trims unnecessary characters,
 // replaces common errors, and converts the domain to ASCII and lowercase.
 // If there is an error during the conversion to ASCII, it is ignored and the original
 // domain is returned instead.
-func NormalizeDomain(domain string) string {
+func Domain(domain string) string {
 	// Remove the protocol or its misspellings, if present
 	domain = removeMisspelledProtocol(domain)
 
@@ -40,11 +41,11 @@ func NormalizeDomain(domain string) string {
 	return lowerDomain
 }
 
-// NormalizeURL takes a URL string containing misprints and
+// URL takes a URL string containing misprints and
 // attempts to construct the correct URL. It fixes common misprints,
-// normalizes the domain using the NormalizeDomain function, and
+// normalizes the domain using the Domain function, and
 // removes any URL fragments.
-func NormalizeURL(u string) string {
+func URL(u string) string {
 	// Fix the misspelled protocol, if present
 	u = replaceMisspelledProtocol(u)
 
@@ -63,7 +64,7 @@ func NormalizeURL(u string) string {
 	// Normalize the domain.
 	domain := nurl.Hostname()
 	port := nurl.Port()
-	nurl.Host = NormalizeDomain(domain)
+	nurl.Host = Domain(domain)
 
 	// Add the port back to the normalized domain, if present.
 	if port != "" {
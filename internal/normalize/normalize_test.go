@@ -1,4 +1,4 @@
-package main
+package normalize
 
 import (
 	"io"
@@ -12,8 +12,8 @@ func init() {
 	logger.LogInit(io.Discard, os.Stdout, os.Stderr, os.Stderr)
 }
 
-// TestNormalizeDomain tests the NormalizeDomain function.
-func TestNormalizeDomain(t *testing.T) {
+// TestDomain tests the Domain function.
+func TestDomain(t *testing.T) {
 	testCases := []struct {
 		input    string
 		expected string
@@ -34,7 +34,7 @@ func TestNormalizeDomain(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.input, func(t *testing.T) {
-			result := NormalizeDomain(tc.input)
+			result := Domain(tc.input)
 			if result != tc.expected {
 				t.Errorf("expected %q, got %q", tc.expected, result)
 			}
@@ -42,8 +42,8 @@ func TestNormalizeDomain(t *testing.T) {
 	}
 }
 
-// TestNormalizeURL tests the NormalizeURL function.
-func TestNormalizeURL(t *testing.T) {
+// TestURL tests the URL function.
+func TestURL(t *testing.T) {
 	testCases := []struct {
 		input    string
 		expected string
@@ -61,7 +61,7 @@ func TestNormalizeURL(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.input, func(t *testing.T) {
-			result := NormalizeURL(tc.input)
+			result := URL(tc.input)
 			if result != tc.expected {
 				t.Errorf("expected %q, got %q", tc.expected, result)
 			}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/normalize"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Lint reasons.
+const (
+	lintReasonTrimmed     = "trimmed"
+	lintReasonCanonical   = "canonical form"
+	lintReasonNormalized  = "normalized"
+	lintReasonBadIPv4     = "malformed IPv4 address"
+	lintReasonBadIPv6     = "malformed IPv6 address"
+	lintReasonBadCIDR     = "malformed CIDR"
+	lintReasonWrongFamily = "wrong address family"
+	lintReasonBadDomain   = "malformed domain name"
+	lintReasonBadURL      = "malformed URL"
+	lintReasonNoHost      = "URL without host"
+	lintReasonBadHost     = "malformed URL host"
+)
+
+// lintIndex - repaired and invalid elements by content ID.
+type lintIndex map[int32][]*pb.LintElement
+
+// set - replaces the elements of the record, the record without them is dropped.
+func (index lintIndex) set(id int32, elements []*pb.LintElement) {
+	if len(elements) == 0 {
+		delete(index, id)
+
+		return
+	}
+
+	index[id] = elements
+}
+
+// count - number of repaired and invalid elements.
+func (index lintIndex) count() (repaired, invalid int) {
+	for _, elements := range index {
+		for _, element := range elements {
+			switch element.GetStatus() {
+			case pb.LintStatus_LINT_REPAIRED:
+				repaired++
+			case pb.LintStatus_LINT_INVALID:
+				invalid++
+			}
+		}
+	}
+
+	return repaired, invalid
+}
+
+func newLintElement(element, value string, status pb.LintStatus, reason, repaired string) *pb.LintElement {
+	return &pb.LintElement{Element: element, Value: value, Status: status, Reason: reason, Repaired: repaired}
+}
+
+// keep - collects the repaired or the invalid element, false if the element is quarantined.
+func (record *Content) keep(issue *pb.LintElement) bool {
+	if issue == nil {
+		return true
+	}
+
+	record.Lint = append(record.Lint, issue)
+
+	return issue.GetStatus() != pb.LintStatus_LINT_INVALID
+}
+
+// lintIPv4 - IPv4 address of <ip>, surrounding spaces are trimmed.
+// IPv4StrToInt can't tell 255.255.255.255 from an error, it is invalid too.
+func lintIPv4(value string) (uint32, *pb.LintElement) {
+	trimmed := strings.TrimSpace(value)
+
+	ip4 := IPv4StrToInt(trimmed)
+	switch {
+	case ip4 == 0xFFFFFFFF:
+		return 0, newLintElement(elementIP4, value, pb.LintStatus_LINT_INVALID, lintReasonBadIPv4, "")
+	case trimmed != value:
+		return ip4, newLintElement(elementIP4, value, pb.LintStatus_LINT_REPAIRED, lintReasonTrimmed, int2Ip4(ip4))
+	case int2Ip4(ip4) != value: // leading zeros.
+		return ip4, newLintElement(elementIP4, value, pb.LintStatus_LINT_REPAIRED, lintReasonCanonical, int2Ip4(ip4))
+	}
+
+	return ip4, nil
+}
+
+// lintIPv6 - IPv6 address of <ipv6>, IPv4 addresses are invalid.
+func lintIPv6(value string) (net.IP, *pb.LintElement) {
+	trimmed := strings.TrimSpace(value)
+
+	ip6 := net.ParseIP(trimmed)
+	switch {
+	case ip6 == nil:
+		return nil, newLintElement(elementIP6, value, pb.LintStatus_LINT_INVALID, lintReasonBadIPv6, "")
+	case !strings.Contains(trimmed, ":"):
+		return nil, newLintElement(elementIP6, value, pb.LintStatus_LINT_INVALID, lintReasonWrongFamily, "")
+	case trimmed != value:
+		return ip6, newLintElement(elementIP6, value, pb.LintStatus_LINT_REPAIRED, lintReasonTrimmed, ip6.String())
+	case ip6.String() != value:
+		return ip6, newLintElement(elementIP6, value, pb.LintStatus_LINT_REPAIRED, lintReasonCanonical, ip6.String())
+	}
+
+	return ip6, nil
+}
+
+// lintSubnet - CIDR of <ipSubnet> or <ipv6Subnet>, host bits are cleared.
+func lintSubnet(element, value string) (string, *pb.LintElement) {
+	trimmed := strings.TrimSpace(value)
+
+	_, network, err := net.ParseCIDR(trimmed)
+	if err != nil {
+		return "", newLintElement(element, value, pb.LintStatus_LINT_INVALID, lintReasonBadCIDR, "")
+	}
+
+	if (network.IP.To4() != nil) != (element == elementIP4Subnet) {
+		return "", newLintElement(element, value, pb.LintStatus_LINT_INVALID, lintReasonWrongFamily, "")
+	}
+
+	subnet := network.String()
+
+	switch {
+	case trimmed != value:
+		return subnet, newLintElement(element, value, pb.LintStatus_LINT_REPAIRED, lintReasonTrimmed, subnet)
+	case subnet != value:
+		return subnet, newLintElement(element, value, pb.LintStatus_LINT_REPAIRED, lintReasonCanonical, subnet)
+	}
+
+	return subnet, nil
+}
+
+// registryHostName - the host as the registry has it: the IP literal or the labels of letters, digits, "-" and "_".
+// isDomainName is stricter, numeric names and labels with edge dashes are blocked too.
+func registryHostName(s string) bool {
+	if net.ParseIP(s) != nil {
+		return true
+	}
+
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// lintDomain - domain of <domain>, the mask wildcard is allowed.
+// The value is kept as is, the index key is normalized anyway.
+// Internationalized names are valid, the normalizer only converts them to ASCII.
+// IP literals are valid, they are indexed as domains as they always were.
+func lintDomain(value string) *pb.LintElement {
+	domain := normalize.Domain(value)
+	if !registryHostName(domain) {
+		return newLintElement(elementDomain, value, pb.LintStatus_LINT_INVALID, lintReasonBadDomain, "")
+	}
+
+	if ascii, err := idna.ToASCII(strings.TrimPrefix(value, "*.")); err != nil || ascii != domain {
+		return newLintElement(elementDomain, value, pb.LintStatus_LINT_REPAIRED, lintReasonNormalized, domain)
+	}
+
+	return nil
+}
+
+// lintURL - URL of <url>, the host must be a domain name or an IP address.
+// The value is kept as is, the index key is normalized anyway.
+func lintURL(value string) *pb.LintElement {
+	normalized := normalize.URL(value)
+
+	u, err := url.Parse(normalized)
+	if err != nil {
+		return newLintElement(elementURL, value, pb.LintStatus_LINT_INVALID, lintReasonBadURL, "")
+	}
+
+	host := u.Hostname()
+
+	switch {
+	case host == "":
+		return newLintElement(elementURL, value, pb.LintStatus_LINT_INVALID, lintReasonNoHost, "")
+	case !registryHostName(host):
+		return newLintElement(elementURL, value, pb.LintStatus_LINT_INVALID, lintReasonBadHost, "")
+	case normalized != value:
+		return newLintElement(elementURL, value, pb.LintStatus_LINT_REPAIRED, lintReasonNormalized, normalized)
+	}
+
+	return nil
+}
+
+// lintReport - data quality report, expects the dump to be read locked by the caller.
+// Valid elements are the kept ones without repaired.
+func (dump *Dump) lintReport(invalidOnly bool) *pb.LintResponse {
+	resp := &pb.LintResponse{RegistryUpdateTime: dump.utime}
+
+	kept := 0
+	for _, pack := range dump.ContentIndex {
+		kept += len(pack.IPv4) + len(pack.IPv6) + len(pack.SubnetIPv4) + len(pack.SubnetIPv6) + len(pack.Domain) + len(pack.URL)
+	}
+
+	repaired, invalid := dump.lint.count()
+
+	resp.Valid, resp.Repaired, resp.Invalid = int32(max(kept-repaired, 0)), int32(repaired), int32(invalid)
+
+	for id, elements := range dump.lint {
+		rec := &pb.LintRecord{Id: id}
+
+		for _, element := range elements {
+			if !invalidOnly || element.GetStatus() == pb.LintStatus_LINT_INVALID {
+				rec.Elements = append(rec.Elements, element)
+			}
+		}
+
+		if len(rec.Elements) > 0 {
+			resp.Records = append(resp.Records, rec)
+		}
+	}
+
+	sort.Slice(resp.Records, func(i, j int) bool { return resp.Records[i].GetId() < resp.Records[j].GetId() })
+
+	return resp
+}
+
+// writeLintText - one line per element: #id element status value [-> repaired] (reason).
+func writeLintText(w *bufio.Writer, resp *pb.LintResponse) {
+	fmt.Fprintf(w, "valid %d repaired %d invalid %d\n", resp.GetValid(), resp.GetRepaired(), resp.GetInvalid())
+
+	for _, rec := range resp.GetRecords() {
+		for _, element := range rec.GetElements() {
+			fmt.Fprintf(w, "#%d %s %s %q", rec.GetId(), element.GetElement(), element.GetStatus(), element.GetValue())
+
+			if element.GetRepaired() != "" {
+				fmt.Fprintf(w, " -> %q", element.GetRepaired())
+			}
+
+			fmt.Fprintf(w, " (%s)\n", element.GetReason())
+		}
+	}
+}
+
+// lintMain - lint command: data quality or structural consistency report of the dump file.
+func lintMain(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	confDumpFile := fs.String("i", "dump.xml", "Dump file, zipped or plain")
+	confInvalidOnly := fs.Bool("invalid", false, "Only records with quarantined elements")
+	confConsistency := fs.Bool("checks", false, "Structural consistency report instead of the element one")
+	confChecks := fs.String("check", "", "Comma separated consistency checks, e.g. ip_without_address, empty is all")
+	confOutput := fs.String("o", offlineOutputText, "Output: text or json")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	checks, err := parseChecks(splitList(*confChecks))
+	if err != nil {
+		logger.Error.Printf("%s\n", err.Error())
+
+		return 1
+	}
+
+	dump, err := loadDump(*confDumpFile, "", "", "")
+	if err != nil {
+		logger.Error.Printf("Can't load dump: %s\n", err.Error())
+
+		return 1
+	}
+
+	dump.RLock()
+	lint := dump.lintReport(*confInvalidOnly)
+	consistency := dump.consistencyReport(checks, 0)
+	dump.RUnlock()
+
+	var resp proto.Message = lint
+	if *confConsistency {
+		resp = consistency
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	switch {
+	case *confOutput == offlineOutputJSON:
+		b, err := protojson.Marshal(resp)
+		if err != nil {
+			logger.Error.Printf("Can't encode report: %s\n", err.Error())
+
+			return 1
+		}
+
+		w.Write(b)
+		w.WriteString("\n")
+	case *confConsistency:
+		writeConsistencyText(w, consistency)
+	default:
+		writeLintText(w, lint)
+	}
+
+	return 0
+}
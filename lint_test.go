@@ -0,0 +1,129 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestLintElements(t *testing.T) {
+	tests := []struct {
+		element  string
+		value    string
+		status   pb.LintStatus
+		repaired string
+	}{
+		{elementIP4, "10.1.1.1", pb.LintStatus_LINT_VALID, ""},
+		{elementIP4, " 10.1.1.1\n", pb.LintStatus_LINT_REPAIRED, "10.1.1.1"},
+		{elementIP4, "010.1.1.1", pb.LintStatus_LINT_REPAIRED, "10.1.1.1"},
+		{elementIP4, "10.1.1", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP4, "10.1.1.256", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP6, "fd11:1::1", pb.LintStatus_LINT_VALID, ""},
+		{elementIP6, "FD11:1:0::1", pb.LintStatus_LINT_REPAIRED, "fd11:1::1"},
+		{elementIP6, "fd11:1::1::1", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP6, "10.1.1.1", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP4Subnet, "10.4.0.0/16", pb.LintStatus_LINT_VALID, ""},
+		{elementIP4Subnet, "10.4.1.1/16", pb.LintStatus_LINT_REPAIRED, "10.4.0.0/16"},
+		{elementIP4Subnet, "10.4.0.0/33", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP4Subnet, "fd44::/32", pb.LintStatus_LINT_INVALID, ""},
+		{elementIP6Subnet, "fd44::/32", pb.LintStatus_LINT_VALID, ""},
+		{elementIP6Subnet, "10.4.0.0/16", pb.LintStatus_LINT_INVALID, ""},
+		{elementDomain, "www.e01.tld", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "*.e01.tld", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "пример.рф", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "WWW.e01.tld.", pb.LintStatus_LINT_REPAIRED, "www.e01.tld"},
+		{elementDomain, "http://www.e01.tld/", pb.LintStatus_LINT_REPAIRED, "www.e01.tld"},
+		{elementDomain, "1.2.3.4", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "-e01.tld", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "123.45", pb.LintStatus_LINT_VALID, ""},
+		{elementDomain, "www..e01.tld", pb.LintStatus_LINT_INVALID, ""},
+		{elementDomain, "", pb.LintStatus_LINT_INVALID, ""},
+		{elementURL, "http://www.e01.tld/cheese", pb.LintStatus_LINT_VALID, ""},
+		{elementURL, "http://10.1.1.1/cheese", pb.LintStatus_LINT_VALID, ""},
+		{elementURL, "http://WWW.e01.tld/cheese#top", pb.LintStatus_LINT_REPAIRED, "http://www.e01.tld/cheese"},
+		{elementURL, "http://-e01.tld/cheese", pb.LintStatus_LINT_VALID, ""},
+		{elementURL, "/cheese", pb.LintStatus_LINT_INVALID, ""},
+		{elementURL, "http://www e01/cheese", pb.LintStatus_LINT_INVALID, ""},
+	}
+
+	for _, tt := range tests {
+		var issue *pb.LintElement
+
+		switch tt.element {
+		case elementIP4:
+			_, issue = lintIPv4(tt.value)
+		case elementIP6:
+			_, issue = lintIPv6(tt.value)
+		case elementIP4Subnet, elementIP6Subnet:
+			_, issue = lintSubnet(tt.element, tt.value)
+		case elementDomain:
+			issue = lintDomain(tt.value)
+		case elementURL:
+			issue = lintURL(tt.value)
+		}
+
+		if issue.GetStatus() != tt.status || issue.GetRepaired() != tt.repaired {
+			t.Errorf("%s %q: Expected %s %q, got %s %q", tt.element, tt.value, tt.status, tt.repaired, issue.GetStatus(), issue.GetRepaired())
+		}
+	}
+}
+
+func TestLintQuarantine(t *testing.T) {
+	CurrentDump = NewDump()
+
+	dump := strings.Replace(xml01, `<ip>10.4.4.4</ip>`, `<ip>10.4.4.4.4</ip>
+        <ip> 10.4.4.5 </ip>
+        <ipSubnet>10.5.0.0/33</ipSubnet>
+        <ipv6Subnet>garbage</ipv6Subnet>`, 1)
+	dump = strings.Replace(dump, `<ip>192.168.5.55</ip>`, `<domain><![CDATA[www..e05.tld]]></domain>
+        <domain><![CDATA[1.2.3.4]]></domain>
+        <ipv6>not an address</ipv6>`, 1)
+
+	if err := Parse(strings.NewReader(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := CurrentDump.IPv4Index[0xFFFFFFFF]; ok {
+		t.Errorf("Expected no malformed IPv4 in the index")
+	}
+
+	if _, ok := CurrentDump.IPv4Index[IPv4StrToInt("10.4.4.5")]; !ok {
+		t.Errorf("Expected repaired IPv4 in the index")
+	}
+
+	if _, ok := CurrentDump.IPv6Index[""]; ok {
+		t.Errorf("Expected no malformed IPv6 in the index")
+	}
+
+	if len(CurrentDump.subnetIPv6Index) != 0 || len(CurrentDump.ContentIndex[555].Domain) != 2 {
+		t.Errorf("Expected quarantined subnets and domains")
+	}
+
+	if _, ok := CurrentDump.domainIndex["1.2.3.4"]; !ok {
+		t.Errorf("Expected IP literal domain in the index")
+	}
+
+	resp := CurrentDump.lintReport(true)
+	if resp.GetRepaired() != 1 || resp.GetInvalid() != 5 || len(resp.GetRecords()) != 2 {
+		t.Fatalf("Expected 1 repaired, 5 invalid in 2 records, got %d %d %d", resp.GetRepaired(), resp.GetInvalid(), len(resp.GetRecords()))
+	}
+
+	if resp.GetRecords()[0].GetId() != 444 || len(resp.GetRecords()[0].GetElements()) != 3 {
+		t.Errorf("Expected 3 invalid elements of 444, got %v", resp.GetRecords()[0])
+	}
+
+	stats := Summary.Load().(*SummaryValues)
+	if stats.RepairedElements != 1 || stats.QuarantinedElements != 5 {
+		t.Errorf("Expected 1 repaired, 5 quarantined, got %d %d", stats.RepairedElements, stats.QuarantinedElements)
+	}
+
+	// the fixed record leaves the report.
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp := CurrentDump.lintReport(false); len(resp.GetRecords()) != 0 {
+		t.Errorf("Expected empty report, got %v", resp.GetRecords())
+	}
+}
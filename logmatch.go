@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// Log formats.
+const (
+	logFormatCommon   = "common"
+	logFormatCombined = "combined"
+	logFormatJSON     = "json"
+	logFormatNetflow  = "netflow"
+	logFormatList     = "list"
+)
+
+// Default fields of JSON logs and columns of netflow CSV, the first present one of CSV is used.
+const (
+	logDefaultJSONFields    = "url,uri,request_url,host,domain,server_name,dst_ip,dst_addr,server_ip"
+	logDefaultNetflowFields = "da,dst_ip,dstaddr,dst_addr,dst"
+)
+
+// Errors
+var (
+	ErrUnknownLogFormat = errors.New("unknown log format")
+	ErrNoNetflowColumn  = errors.New("no destination address column")
+)
+
+// logRequestRe - method and target of common and combined log formats, the tail of combined is ignored.
+var logRequestRe = regexp.MustCompile(`^\S+ \S+ \S+ \[[^\]]*\] "(\S+) (\S+)[^"]*"`)
+
+// logMatcher - matches log records against the dump and writes the matches.
+// Output is tab separated: source:line, query, content ID, match reason, match key, decision org, number and date.
+type logMatcher struct {
+	dump   *Dump // read locked by the caller.
+	format string
+	fields []string
+	w      io.Writer
+	count  int
+}
+
+// newLogMatcher - matcher for the format, empty fields are the format defaults.
+func newLogMatcher(dump *Dump, format, fields string, w io.Writer) (*logMatcher, error) {
+	switch format {
+	case logFormatCommon, logFormatCombined, logFormatList:
+	case logFormatJSON:
+		if fields == "" {
+			fields = logDefaultJSONFields
+		}
+	case logFormatNetflow:
+		if fields == "" {
+			fields = logDefaultNetflowFields
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLogFormat, format)
+	}
+
+	return &logMatcher{dump: dump, format: format, fields: splitList(fields), w: w}, nil
+}
+
+// match - streams the log and writes matches.
+func (m *logMatcher) match(r io.Reader, source string) error {
+	if m.format == logFormatNetflow {
+		return m.matchNetflow(r, source)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		m.matchQueries(source, line, m.queries(scanner.Text()))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read %s: %w", source, err)
+	}
+
+	return nil
+}
+
+// queries - URLs, host:port, domains and addresses of the log line.
+func (m *logMatcher) queries(text string) []string {
+	switch m.format {
+	case logFormatCommon, logFormatCombined:
+		sm := logRequestRe.FindStringSubmatch(text)
+		if sm == nil {
+			return nil
+		}
+
+		// origin-form targets have no host.
+		if sm[1] != "CONNECT" && !strings.Contains(sm[2], "://") {
+			return nil
+		}
+
+		return []string{sm[2]}
+	case logFormatJSON:
+		var record map[string]any
+
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			logger.Debug.Printf("Bad JSON log line: %s\n", err.Error())
+
+			return nil
+		}
+
+		var queries []string
+
+		for _, field := range m.fields {
+			if s, ok := record[field].(string); ok && s != "" {
+				queries = append(queries, s)
+			}
+		}
+
+		return queries
+	default:
+		text = strings.TrimSpace(text)
+		if text == "" || strings.HasPrefix(text, "#") {
+			return nil
+		}
+
+		return []string{text}
+	}
+}
+
+// matchNetflow - CSV with the header, the destination address column is the first present of the fields.
+func (m *logMatcher) matchNetflow(r io.Reader, source string) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("read %s header: %w", source, err)
+	}
+
+	column := -1
+
+	for _, field := range m.fields {
+		for i, name := range header {
+			if strings.TrimSpace(name) == field {
+				column = i
+
+				break
+			}
+		}
+
+		if column >= 0 {
+			break
+		}
+	}
+
+	if column < 0 {
+		return fmt.Errorf("%w: %s", ErrNoNetflowColumn, source)
+	}
+
+	for {
+		record, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("read %s: %w", source, err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		// summary lines of nfdump.
+		if column >= len(record) || net.ParseIP(strings.TrimSpace(record[column])) == nil {
+			continue
+		}
+
+		m.matchQueries(source, line, []string{strings.TrimSpace(record[column])})
+	}
+}
+
+// matchQueries - writes every content matched by the queries of the line once.
+func (m *logMatcher) matchQueries(source string, line int, queries []string) {
+	seen := make(Int32Map)
+
+	for _, query := range queries {
+		for _, match := range m.dump.searchResource(query) {
+			if _, ok := seen[match.ID]; ok {
+				continue
+			}
+
+			seen[match.ID] = Nothing{}
+
+			cont, ok := m.dump.ContentIndex[match.ID]
+			if !ok {
+				continue
+			}
+
+			decision := cont.Record().GetDecision()
+
+			fmt.Fprintf(m.w, "%s:%d\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n", source, line, query, match.ID,
+				match.Reason, match.Key, decision.GetOrg(), decision.GetNumber(), decision.GetDate())
+
+			m.count++
+		}
+	}
+}
+
+// matchMain - match command: matches access logs, netflow CSV or plain lists against the dump or the history snapshot.
+func matchMain(args []string) int {
+	fs := flag.NewFlagSet("match", flag.ExitOnError)
+	confDumpFile := fs.String("i", "res/dump.xml", "Dump file, zipped or plain")
+	confHistoryFile := fs.String("history", "", "History file, the snapshot is used instead of the dump file")
+	confAt := fs.String("at", "", "Snapshot time, RFC 3339, empty is the latest")
+	confFormat := fs.String("f", logFormatCombined, "Log format: common, combined, json, netflow or list")
+	confFields := fs.String("fields", "", "Comma separated JSON fields or netflow CSV columns, empty is the format default")
+	confOutput := fs.String("o", "", "Output file, empty is stdout")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	dump, err := loadDump(*confDumpFile, *confHistoryFile, *confAt, "")
+	if err != nil {
+		logger.Error.Printf("Can't load dump: %s\n", err.Error())
+
+		return 1
+	}
+
+	out := os.Stdout
+	if *confOutput != "" {
+		f, err := os.Create(*confOutput)
+		if err != nil {
+			logger.Error.Printf("Can't create output: %s\n", err.Error())
+
+			return 1
+		}
+
+		defer f.Close()
+
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+
+	dump.RLock()
+	defer dump.RUnlock()
+
+	m, err := newLogMatcher(dump, *confFormat, *confFields, w)
+	if err != nil {
+		logger.Error.Printf("Bad format: %s\n", err.Error())
+
+		return 1
+	}
+
+	sources := fs.Args()
+	if len(sources) == 0 {
+		sources = []string{"-"}
+	}
+
+	for _, source := range sources {
+		if err := matchLogFile(m, source); err != nil {
+			logger.Error.Printf("Can't match: %s\n", err.Error())
+
+			return 1
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		logger.Error.Printf("Can't write output: %s\n", err.Error())
+
+		return 1
+	}
+
+	logger.Info.Printf("Matches: %d\n", m.count)
+
+	return 0
+}
+
+// matchLogFile - the log file, "-" is stdin.
+func matchLogFile(m *logMatcher, source string) error {
+	if source == "-" {
+		return m.match(os.Stdin, "stdin")
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("open log: %w", err)
+	}
+
+	defer f.Close()
+
+	return m.match(f, source)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogMatcher(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		fields string
+		log    string
+		want   []string
+	}{
+		{"combined", logFormatCombined, "",
+			`10.0.0.1 - - [01/Jan/2011:01:01:01 +0300] "GET http://www.e01.tld/cheese HTTP/1.1" 200 512 "-" "curl/8.0"` + "\n" +
+				`10.0.0.1 - - [01/Jan/2011:01:01:02 +0300] "GET /cheese HTTP/1.1" 200 512 "-" "curl/8.0"` + "\n" +
+				`10.0.0.1 - - [01/Jan/2011:01:01:03 +0300] "CONNECT www.e02.tld:443 HTTP/1.1" 200 0 "-" "curl/8.0"` + "\n",
+			[]string{
+				"log:1\thttp://www.e01.tld/cheese\t111\tMATCH_URL\thttp://www.e01.tld/cheese\tONE\t1/1/11-1111\t2000-01-01",
+				"log:3\twww.e02.tld:443\t222\tMATCH_DOMAIN\twww.e02.tld\tTWO\t2/2/22-2222\t2000-01-02",
+				"log:3\twww.e02.tld:443\t555\tMATCH_DOMAIN\twww.e02.tld\tFIVE\t5/5/55-5555\t2001-01-05",
+			}},
+		{"json", logFormatJSON, "",
+			`{"url": "http://example.com/", "dst_ip": "10.4.1.1"}` + "\n" + `not json` + "\n" + `{"host": "WWW.E01.TLD", "dst_ip": "192.168.1.11"}` + "\n",
+			[]string{
+				"log:1\t10.4.1.1\t444\tMATCH_IPV4_SUBNET\t10.4.0.0/16\tFOUR\t4/4/44-4444\t2001-01-04",
+				"log:3\tWWW.E01.TLD\t111\tMATCH_DOMAIN\twww.e01.tld\tONE\t1/1/11-1111\t2000-01-01",
+			}},
+		{"netflow", logFormatNetflow, "",
+			"ts,te,sa,da,sp,dp\n2011-01-01 01:01:01,2011-01-01 01:01:02,10.0.0.1,192.168.3.33,5000,80\n2011-01-01 01:01:01,2011-01-01 01:01:02,10.0.0.1,10.9.9.9,5000,80\nSummary\n",
+			[]string{"log:2\t192.168.3.33\t333\tMATCH_IPV4\t192.168.3.33\tTHREE\t3/3/33-3333\t2001-01-03"}},
+		{"list", logFormatList, "",
+			"# comment\n\nfd44:4::1\nhttps://www.e01.tld/sex\n",
+			[]string{
+				"log:3\tfd44:4::1\t444\tMATCH_IPV6\tfd44:4::1\tFOUR\t4/4/44-4444\t2001-01-04",
+				"log:4\thttps://www.e01.tld/sex\t111\tMATCH_URL\thttps://www.e01.tld/sex\tONE\t1/1/11-1111\t2000-01-01",
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+
+			CurrentDump.RLock()
+			defer CurrentDump.RUnlock()
+
+			m, err := newLogMatcher(CurrentDump, tt.format, tt.fields, &out)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := m.match(strings.NewReader(tt.log), "log"); err != nil {
+				t.Fatal(err)
+			}
+
+			got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+			if out.Len() == 0 {
+				got = nil
+			}
+
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Expected\n%s\ngot\n%s", strings.Join(tt.want, "\n"), strings.Join(got, "\n"))
+			}
+
+			if m.count != len(tt.want) {
+				t.Errorf("Expected %d, got %d", len(tt.want), m.count)
+			}
+		})
+	}
+
+	if _, err := newLogMatcher(CurrentDump, "syslog", "", nil); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
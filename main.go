@@ -12,6 +12,7 @@ import (
 	"os/signal"
 	"runtime/debug"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -19,8 +20,42 @@ import (
 	pb "github.com/usher2/u2ckdump/msg"
 )
 
+// logInit - init loggers for the level.
+func logInit(level string) {
+	switch level {
+	case "Info":
+		logger.LogInit(io.Discard, os.Stdout, os.Stderr, os.Stderr)
+	case "Warning":
+		logger.LogInit(io.Discard, io.Discard, os.Stderr, os.Stderr)
+	case "Error":
+		logger.LogInit(io.Discard, io.Discard, io.Discard, os.Stderr)
+	default:
+		logger.LogInit(os.Stderr, os.Stdout, os.Stderr, os.Stderr)
+	}
+}
+
 func main() {
 	debug.SetGCPercent(20)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "replay":
+			os.Exit(replayMain(os.Args[2:]))
+		case "export":
+			os.Exit(exportMain(os.Args[2:]))
+		case "rpz":
+			os.Exit(rpzMain(os.Args[2:]))
+		case "squid":
+			os.Exit(squidMain(os.Args[2:]))
+		case "match":
+			os.Exit(matchMain(os.Args[2:]))
+		case "query":
+			os.Exit(offlineMain(os.Args[2:]))
+		case "diff":
+			os.Exit(diffMain(os.Args[2:]))
+		case "lint":
+			os.Exit(lintMain(os.Args[2:]))
+		}
+	}
 	//go func() {
 	//	logger.Println(http.ListenAndServe("localhost:6060", nil))
 	//}()
@@ -29,16 +64,47 @@ func main() {
 	confPBPort := flag.String("p", "50001", "gRPC port")
 	confDumpCacheDir := flag.String("d", "res", "Dump cache dir")
 	confLogLevel := flag.String("l", "Debug", "Logging level")
+	confJSONPack := flag.Bool("j", false, "Fill deprecated JSON pack field in results")
+	confTombstoneRetention := flag.Duration("t", TombstoneRetention, "Keep removed records searchable for")
+	confMaxShrink := flag.Float64("max-shrink", MaxDumpShrink, "Refuse the dump losing more than this share of the records, 0 disables")
+	confHistoryFile := flag.String("history", "", "Registry history file, empty disables history")
+	confSeriesFile := flag.String("series", "", "Summary time series file, empty keeps series in memory only")
+	confDNSBLAddr := flag.String("dnsbl", "", "DNSBL listen address, e.g. :5353, empty disables")
+	confDNSBLZone := flag.String("dnsbl-zone", "rkn.local", "DNSBL zone")
+	confICAPAddr := flag.String("icap", "", "ICAP REQMOD listen address, e.g. :1344, empty disables")
+	confICAPPage := flag.String("icap-page", "", "ICAP block page html/template file, empty is the built-in page")
+	confAdminAddr := flag.String("admin", "", "Admin gRPC listen address, e.g. 127.0.0.1:50002 or unix:/run/u2ckdump.sock, empty disables")
+	confAdminToken := flag.String("admin-token", os.Getenv("U2CKDUMP_ADMIN_TOKEN"), "Admin bearer token, U2CKDUMP_ADMIN_TOKEN by default, required on TCP")
+	confAdminCert := flag.String("admin-cert", "", "Admin TLS certificate file, required on non-loopback TCP")
+	confAdminKey := flag.String("admin-key", "", "Admin TLS key file")
+	confAdminUploadMax := flag.Int64("admin-upload-max", 1<<30, "Admin upload size limit in bytes, 0 is unlimited")
+	confWatchDir := flag.String("watch", "", "Drop directory of dump archives watched instead of polling the dump API, empty polls")
+	confWatchSettle := flag.Duration("watch-settle", 5*time.Second, "Dropped archive is processed when it is unchanged for")
+	confWatchInterval := flag.Duration("watch-interval", 10*time.Second, "Drop directory scan interval without inotify")
+	confAuditInterval := flag.Duration("audit", 0, "Index audit interval, 0 disables")
+	confAuditRepair := flag.Bool("audit-repair", false, "Repair the indexes on periodic audit findings")
 	flag.Parse()
-	switch *confLogLevel {
-	case "Info":
-		logger.LogInit(io.Discard, os.Stdout, os.Stderr, os.Stderr)
-	case "Warning":
-		logger.LogInit(io.Discard, io.Discard, os.Stderr, os.Stderr)
-	case "Error":
-		logger.LogInit(io.Discard, io.Discard, io.Discard, os.Stderr)
-	default:
-		logger.LogInit(os.Stderr, os.Stdout, os.Stderr, os.Stderr)
+	JSONPack = *confJSONPack
+	TombstoneRetention = *confTombstoneRetention
+	MaxDumpShrink = *confMaxShrink
+	logInit(*confLogLevel)
+	if *confHistoryFile != "" {
+		history, err := LoadHistory(*confHistoryFile)
+		if err != nil {
+			logger.Error.Printf("Can't load history: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		CurrentDump.history = history
+	}
+	if *confSeriesFile != "" {
+		series, err := LoadSeries(*confSeriesFile)
+		if err != nil {
+			logger.Error.Printf("Can't load series: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		StatSeries = series
 	}
 	if _, err := os.Stat(*confDumpCacheDir + "/current"); !os.IsNotExist(err) {
 		err := os.Remove(*confDumpCacheDir + "/current") // remove cache
@@ -67,6 +133,7 @@ func main() {
 				logger.Error.Printf("Parse error: %s\n", err.Error())
 			} else {
 				logger.Info.Printf("Dump parsed")
+				DumpStatus.applied(*confDumpCacheDir + "/dump.xml")
 			}
 			dumpFile.Close()
 		}
@@ -81,6 +148,51 @@ func main() {
 	serverGRPC := grpc.NewServer()
 	pb.RegisterCheckServer(serverGRPC, &server{})
 
+	var adminGRPC *grpc.Server
+	if *confAdminAddr != "" {
+		adminGRPC, err = newAdminServer(*confAdminAddr, *confAdminToken, *confAdminCert, *confAdminKey)
+		if err != nil {
+			logger.Error.Printf("Failed to configure admin: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		adminListen, err := listenAdmin(*confAdminAddr)
+		if err != nil {
+			logger.Error.Printf("Failed to listen admin: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		pb.RegisterAdminServer(adminGRPC, &adminServer{url: *confAPIURL, token: *confAPIKey, dir: *confDumpCacheDir, uploadMax: *confAdminUploadMax})
+
+		go func() {
+			if err := adminGRPC.Serve(adminListen); err != nil {
+				logger.Error.Printf("Failed to serve admin: %s\n", err.Error())
+			}
+		}()
+	}
+
+	var dnsbl *DNSBL
+	if *confDNSBLAddr != "" {
+		dnsbl, err = ListenDNSBL(*confDNSBLAddr, *confDNSBLZone)
+		if err != nil {
+			logger.Error.Printf("Failed to listen DNSBL: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		go dnsbl.Serve()
+	}
+
+	var icap *ICAPServer
+	if *confICAPAddr != "" {
+		icap, err = ListenICAP(*confICAPAddr, *confICAPPage)
+		if err != nil {
+			logger.Error.Printf("Failed to listen ICAP: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		go icap.Serve()
+	}
+
 	quit := make(chan os.Signal, 1)
 	done := make(chan struct{})
 	killPoll := make(chan struct{})
@@ -93,6 +205,18 @@ func main() {
 
 		close(killPoll)
 
+		if dnsbl != nil {
+			dnsbl.Close()
+		}
+
+		if icap != nil {
+			icap.Close()
+		}
+
+		if adminGRPC != nil {
+			adminGRPC.GracefulStop()
+		}
+
 		serverGRPC.GracefulStop()
 
 		<-donePoll
@@ -100,7 +224,21 @@ func main() {
 		close(done)
 	}()
 
-	go DumpPoll(serverGRPC, donePoll, killPoll, *confAPIURL, *confAPIKey, *confDumpCacheDir, 60)
+	if *confAuditInterval > 0 {
+		go AuditPoll(killPoll, *confAuditInterval, *confAuditRepair)
+	}
+
+	if *confWatchDir != "" {
+		watcher, err := newDropWatcher(*confWatchDir, *confWatchInterval)
+		if err != nil {
+			logger.Error.Printf("Failed to watch %s: %s\n", *confWatchDir, err.Error())
+			os.Exit(1)
+		}
+
+		go DumpWatch(donePoll, killPoll, watcher, *confWatchDir, *confDumpCacheDir, *confWatchSettle)
+	} else {
+		go DumpPoll(serverGRPC, donePoll, killPoll, *confAPIURL, *confAPIKey, *confDumpCacheDir, 60)
+	}
 
 	if err := serverGRPC.Serve(listen); err != nil {
 		logger.Error.Printf("Failed to serve: %v", err.Error())
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.12.4
 // source: msg.proto
 
@@ -20,12 +20,461 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Order int32
+
+const (
+	Order_ORDER_MATCH        Order = 0
+	Order_ORDER_CONTENT_ID   Order = 1
+	Order_ORDER_INCLUDE_TIME Order = 2
+)
+
+// Enum value maps for Order.
+var (
+	Order_name = map[int32]string{
+		0: "ORDER_MATCH",
+		1: "ORDER_CONTENT_ID",
+		2: "ORDER_INCLUDE_TIME",
+	}
+	Order_value = map[string]int32{
+		"ORDER_MATCH":        0,
+		"ORDER_CONTENT_ID":   1,
+		"ORDER_INCLUDE_TIME": 2,
+	}
+)
+
+func (x Order) Enum() *Order {
+	p := new(Order)
+	*p = x
+	return p
+}
+
+func (x Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[0].Descriptor()
+}
+
+func (Order) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[0]
+}
+
+func (x Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order.Descriptor instead.
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{0}
+}
+
+type Resolution int32
+
+const (
+	Resolution_RESOLUTION_DAILY  Resolution = 0
+	Resolution_RESOLUTION_HOURLY Resolution = 1
+)
+
+// Enum value maps for Resolution.
+var (
+	Resolution_name = map[int32]string{
+		0: "RESOLUTION_DAILY",
+		1: "RESOLUTION_HOURLY",
+	}
+	Resolution_value = map[string]int32{
+		"RESOLUTION_DAILY":  0,
+		"RESOLUTION_HOURLY": 1,
+	}
+)
+
+func (x Resolution) Enum() *Resolution {
+	p := new(Resolution)
+	*p = x
+	return p
+}
+
+func (x Resolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Resolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[1].Descriptor()
+}
+
+func (Resolution) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[1]
+}
+
+func (x Resolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Resolution.Descriptor instead.
+func (Resolution) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{1}
+}
+
+type Presence int32
+
+const (
+	Presence_PRESENCE_ANY Presence = 0
+	Presence_PRESENCE_YES Presence = 1
+	Presence_PRESENCE_NO  Presence = 2
+)
+
+// Enum value maps for Presence.
+var (
+	Presence_name = map[int32]string{
+		0: "PRESENCE_ANY",
+		1: "PRESENCE_YES",
+		2: "PRESENCE_NO",
+	}
+	Presence_value = map[string]int32{
+		"PRESENCE_ANY": 0,
+		"PRESENCE_YES": 1,
+		"PRESENCE_NO":  2,
+	}
+)
+
+func (x Presence) Enum() *Presence {
+	p := new(Presence)
+	*p = x
+	return p
+}
+
+func (x Presence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presence) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[2].Descriptor()
+}
+
+func (Presence) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[2]
+}
+
+func (x Presence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Presence.Descriptor instead.
+func (Presence) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{2}
+}
+
+type TimeField int32
+
+const (
+	TimeField_TIME_INCLUDE TimeField = 0 // record includeTime
+	TimeField_TIME_RECORD  TimeField = 1 // record ts
+	TimeField_TIME_ELEMENT TimeField = 2 // url, domain, ip, subnet ts, one result per element
+)
+
+// Enum value maps for TimeField.
+var (
+	TimeField_name = map[int32]string{
+		0: "TIME_INCLUDE",
+		1: "TIME_RECORD",
+		2: "TIME_ELEMENT",
+	}
+	TimeField_value = map[string]int32{
+		"TIME_INCLUDE": 0,
+		"TIME_RECORD":  1,
+		"TIME_ELEMENT": 2,
+	}
+)
+
+func (x TimeField) Enum() *TimeField {
+	p := new(TimeField)
+	*p = x
+	return p
+}
+
+func (x TimeField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeField) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeField) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[3]
+}
+
+func (x TimeField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeField.Descriptor instead.
+func (TimeField) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{3}
+}
+
+type LintStatus int32
+
+const (
+	LintStatus_LINT_VALID    LintStatus = 0
+	LintStatus_LINT_REPAIRED LintStatus = 1
+	LintStatus_LINT_INVALID  LintStatus = 2
+)
+
+// Enum value maps for LintStatus.
+var (
+	LintStatus_name = map[int32]string{
+		0: "LINT_VALID",
+		1: "LINT_REPAIRED",
+		2: "LINT_INVALID",
+	}
+	LintStatus_value = map[string]int32{
+		"LINT_VALID":    0,
+		"LINT_REPAIRED": 1,
+		"LINT_INVALID":  2,
+	}
+)
+
+func (x LintStatus) Enum() *LintStatus {
+	p := new(LintStatus)
+	*p = x
+	return p
+}
+
+func (x LintStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LintStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[4].Descriptor()
+}
+
+func (LintStatus) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[4]
+}
+
+func (x LintStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LintStatus.Descriptor instead.
+func (LintStatus) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{4}
+}
+
+type ConsistencyCheck int32
+
+const (
+	ConsistencyCheck_CHECK_NONE                  ConsistencyCheck = 0
+	ConsistencyCheck_CHECK_DOMAIN_WITHOUT_DOMAIN ConsistencyCheck = 1 // domain or domain-mask block without <domain>
+	ConsistencyCheck_CHECK_IP_WITHOUT_ADDRESS    ConsistencyCheck = 2 // ip block without <ip>, <ipv6> or subnets
+	ConsistencyCheck_CHECK_MASK_WITHOUT_WILDCARD ConsistencyCheck = 3 // domain-mask <domain> without *.
+	ConsistencyCheck_CHECK_URL_HOST_NOT_INDEXED  ConsistencyCheck = 4 // URL host is not in the domain index
+	ConsistencyCheck_CHECK_DUPLICATE_ELEMENT     ConsistencyCheck = 5 // the same normalized element twice in the record
+	ConsistencyCheck_CHECK_HASH_UNCHANGED        ConsistencyCheck = 6 // the content changed, the hash attribute did not
+)
+
+// Enum value maps for ConsistencyCheck.
+var (
+	ConsistencyCheck_name = map[int32]string{
+		0: "CHECK_NONE",
+		1: "CHECK_DOMAIN_WITHOUT_DOMAIN",
+		2: "CHECK_IP_WITHOUT_ADDRESS",
+		3: "CHECK_MASK_WITHOUT_WILDCARD",
+		4: "CHECK_URL_HOST_NOT_INDEXED",
+		5: "CHECK_DUPLICATE_ELEMENT",
+		6: "CHECK_HASH_UNCHANGED",
+	}
+	ConsistencyCheck_value = map[string]int32{
+		"CHECK_NONE":                  0,
+		"CHECK_DOMAIN_WITHOUT_DOMAIN": 1,
+		"CHECK_IP_WITHOUT_ADDRESS":    2,
+		"CHECK_MASK_WITHOUT_WILDCARD": 3,
+		"CHECK_URL_HOST_NOT_INDEXED":  4,
+		"CHECK_DUPLICATE_ELEMENT":     5,
+		"CHECK_HASH_UNCHANGED":        6,
+	}
+)
+
+func (x ConsistencyCheck) Enum() *ConsistencyCheck {
+	p := new(ConsistencyCheck)
+	*p = x
+	return p
+}
+
+func (x ConsistencyCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[5].Descriptor()
+}
+
+func (ConsistencyCheck) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[5]
+}
+
+func (x ConsistencyCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyCheck.Descriptor instead.
+func (ConsistencyCheck) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{5}
+}
+
+type AuditProblem int32
+
+const (
+	AuditProblem_AUDIT_NONE     AuditProblem = 0
+	AuditProblem_AUDIT_ORPHANED AuditProblem = 1 // the live posting or network has no record behind it
+	AuditProblem_AUDIT_MISSING  AuditProblem = 2 // the record posting or network is not in the live index
+)
+
+// Enum value maps for AuditProblem.
+var (
+	AuditProblem_name = map[int32]string{
+		0: "AUDIT_NONE",
+		1: "AUDIT_ORPHANED",
+		2: "AUDIT_MISSING",
+	}
+	AuditProblem_value = map[string]int32{
+		"AUDIT_NONE":     0,
+		"AUDIT_ORPHANED": 1,
+		"AUDIT_MISSING":  2,
+	}
+)
+
+func (x AuditProblem) Enum() *AuditProblem {
+	p := new(AuditProblem)
+	*p = x
+	return p
+}
+
+func (x AuditProblem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditProblem) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[6].Descriptor()
+}
+
+func (AuditProblem) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[6]
+}
+
+func (x AuditProblem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditProblem.Descriptor instead.
+func (AuditProblem) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{6}
+}
+
+type MatchReason int32
+
+const (
+	MatchReason_MATCH_NONE                MatchReason = 0
+	MatchReason_MATCH_CONTENT_ID          MatchReason = 1
+	MatchReason_MATCH_IPV4                MatchReason = 2
+	MatchReason_MATCH_IPV4_SUBNET         MatchReason = 3
+	MatchReason_MATCH_IPV6                MatchReason = 4
+	MatchReason_MATCH_IPV6_SUBNET         MatchReason = 5
+	MatchReason_MATCH_DOMAIN              MatchReason = 6
+	MatchReason_MATCH_DOMAIN_PARENT       MatchReason = 7
+	MatchReason_MATCH_DOMAIN_SUFFIX       MatchReason = 8
+	MatchReason_MATCH_URL                 MatchReason = 9
+	MatchReason_MATCH_DECISION            MatchReason = 10
+	MatchReason_MATCH_ENTRY_TYPE          MatchReason = 11
+	MatchReason_MATCH_ORG                 MatchReason = 12
+	MatchReason_MATCH_WITHOUT_DECISION_NO MatchReason = 13
+	MatchReason_MATCH_QUERY               MatchReason = 14
+	MatchReason_MATCH_INCLUDE_TIME        MatchReason = 15
+	MatchReason_MATCH_RECORD_TIME         MatchReason = 16
+	MatchReason_MATCH_ELEMENT_TIME        MatchReason = 17 // matchKey is kind=value: url, domain, ip4, ip6, subnet4, subnet6
+)
+
+// Enum value maps for MatchReason.
+var (
+	MatchReason_name = map[int32]string{
+		0:  "MATCH_NONE",
+		1:  "MATCH_CONTENT_ID",
+		2:  "MATCH_IPV4",
+		3:  "MATCH_IPV4_SUBNET",
+		4:  "MATCH_IPV6",
+		5:  "MATCH_IPV6_SUBNET",
+		6:  "MATCH_DOMAIN",
+		7:  "MATCH_DOMAIN_PARENT",
+		8:  "MATCH_DOMAIN_SUFFIX",
+		9:  "MATCH_URL",
+		10: "MATCH_DECISION",
+		11: "MATCH_ENTRY_TYPE",
+		12: "MATCH_ORG",
+		13: "MATCH_WITHOUT_DECISION_NO",
+		14: "MATCH_QUERY",
+		15: "MATCH_INCLUDE_TIME",
+		16: "MATCH_RECORD_TIME",
+		17: "MATCH_ELEMENT_TIME",
+	}
+	MatchReason_value = map[string]int32{
+		"MATCH_NONE":                0,
+		"MATCH_CONTENT_ID":          1,
+		"MATCH_IPV4":                2,
+		"MATCH_IPV4_SUBNET":         3,
+		"MATCH_IPV6":                4,
+		"MATCH_IPV6_SUBNET":         5,
+		"MATCH_DOMAIN":              6,
+		"MATCH_DOMAIN_PARENT":       7,
+		"MATCH_DOMAIN_SUFFIX":       8,
+		"MATCH_URL":                 9,
+		"MATCH_DECISION":            10,
+		"MATCH_ENTRY_TYPE":          11,
+		"MATCH_ORG":                 12,
+		"MATCH_WITHOUT_DECISION_NO": 13,
+		"MATCH_QUERY":               14,
+		"MATCH_INCLUDE_TIME":        15,
+		"MATCH_RECORD_TIME":         16,
+		"MATCH_ELEMENT_TIME":        17,
+	}
+)
+
+func (x MatchReason) Enum() *MatchReason {
+	p := new(MatchReason)
+	*p = x
+	return p
+}
+
+func (x MatchReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_msg_proto_enumTypes[7].Descriptor()
+}
+
+func (MatchReason) Type() protoreflect.EnumType {
+	return &file_msg_proto_enumTypes[7]
+}
+
+func (x MatchReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchReason.Descriptor instead.
+func (MatchReason) EnumDescriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{7}
+}
+
 type ContentIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query int32 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          int32 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool  `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64 `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *ContentIDRequest) Reset() {
@@ -67,12 +516,36 @@ func (x *ContentIDRequest) GetQuery() int32 {
 	return 0
 }
 
+func (x *ContentIDRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ContentIDRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *ContentIDRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type IPv4Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query uint32 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          uint32 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *IPv4Request) Reset() {
@@ -114,12 +587,36 @@ func (x *IPv4Request) GetQuery() uint32 {
 	return 0
 }
 
+func (x *IPv4Request) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *IPv4Request) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *IPv4Request) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type IPv6Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *IPv6Request) Reset() {
@@ -161,12 +658,36 @@ func (x *IPv6Request) GetQuery() []byte {
 	return nil
 }
 
+func (x *IPv6Request) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *IPv6Request) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *IPv6Request) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type URLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *URLRequest) Reset() {
@@ -208,12 +729,36 @@ func (x *URLRequest) GetQuery() string {
 	return ""
 }
 
+func (x *URLRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *URLRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *URLRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type DomainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *DomainRequest) Reset() {
@@ -255,13 +800,37 @@ func (x *DomainRequest) GetQuery() string {
 	return ""
 }
 
+func (x *DomainRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *DomainRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *DomainRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type SuffixRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query   string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Variant int32  `protobuf:"varint,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Variant        int32  `protobuf:"varint,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *SuffixRequest) Reset() {
@@ -310,14 +879,38 @@ func (x *SuffixRequest) GetVariant() int32 {
 	return 0
 }
 
-type DecisionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Query uint64 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
-}
-
+func (x *SuffixRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SuffixRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *SuffixRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+type DecisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          uint64 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
+}
+
 func (x *DecisionRequest) Reset() {
 	*x = DecisionRequest{}
 	if protoimpl.UnsafeEnabled {
@@ -357,12 +950,36 @@ func (x *DecisionRequest) GetQuery() uint64 {
 	return 0
 }
 
+func (x *DecisionRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *DecisionRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *DecisionRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type TextDecisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *TextDecisionRequest) Reset() {
@@ -404,12 +1021,36 @@ func (x *TextDecisionRequest) GetQuery() string {
 	return ""
 }
 
+func (x *TextDecisionRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *TextDecisionRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *TextDecisionRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type SubnetIPv4Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *SubnetIPv4Request) Reset() {
@@ -451,12 +1092,36 @@ func (x *SubnetIPv4Request) GetQuery() string {
 	return ""
 }
 
+func (x *SubnetIPv4Request) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SubnetIPv4Request) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *SubnetIPv4Request) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type SubnetIPv6Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *SubnetIPv6Request) Reset() {
@@ -498,12 +1163,36 @@ func (x *SubnetIPv6Request) GetQuery() string {
 	return ""
 }
 
+func (x *SubnetIPv6Request) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SubnetIPv6Request) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *SubnetIPv6Request) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type EntryTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
 }
 
 func (x *EntryTypeRequest) Reset() {
@@ -545,6 +1234,27 @@ func (x *EntryTypeRequest) GetQuery() string {
 	return ""
 }
 
+func (x *EntryTypeRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *EntryTypeRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *EntryTypeRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -554,6 +1264,9 @@ type SearchResponse struct {
 	Query              string     `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	RegistryUpdateTime int64      `protobuf:"varint,3,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
 	Results            []*Content `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken      string     `protobuf:"bytes,5,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount         int32      `protobuf:"varint,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Facets             []*Facet   `protobuf:"bytes,7,rep,name=facets,proto3" json:"facets,omitempty"`
 }
 
 func (x *SearchResponse) Reset() {
@@ -616,16 +1329,41 @@ func (x *SearchResponse) GetResults() []*Content {
 	return nil
 }
 
-type SummaryRequest struct {
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// Page - cursor pagination, pageSize 0 means the server default.
+// Without the page all results are returned.
+type Page struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize  int32  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Order     Order  `protobuf:"varint,3,opt,name=order,proto3,enum=msg.Order" json:"order,omitempty"`
 }
 
-func (x *SummaryRequest) Reset() {
-	*x = SummaryRequest{}
+func (x *Page) Reset() {
+	*x = Page{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,13 +1371,13 @@ func (x *SummaryRequest) Reset() {
 	}
 }
 
-func (x *SummaryRequest) String() string {
+func (x *Page) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SummaryRequest) ProtoMessage() {}
+func (*Page) ProtoMessage() {}
 
-func (x *SummaryRequest) ProtoReflect() protoreflect.Message {
+func (x *Page) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,29 +1389,42 @@ func (x *SummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SummaryRequest.ProtoReflect.Descriptor instead.
-func (*SummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SummaryRequest) GetQuery() string {
+func (x *Page) GetPageSize() int32 {
 	if x != nil {
-		return x.Query
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Page) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type SummaryResponse struct {
+func (x *Page) GetOrder() Order {
+	if x != nil {
+		return x.Order
+	}
+	return Order_ORDER_MATCH
+}
+
+type SummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error   string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Summary []byte `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *SummaryResponse) Reset() {
-	*x = SummaryResponse{}
+func (x *SummaryRequest) Reset() {
+	*x = SummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +1432,13 @@ func (x *SummaryResponse) Reset() {
 	}
 }
 
-func (x *SummaryResponse) String() string {
+func (x *SummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SummaryResponse) ProtoMessage() {}
+func (*SummaryRequest) ProtoMessage() {}
 
-func (x *SummaryResponse) ProtoReflect() protoreflect.Message {
+func (x *SummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,35 +1450,29 @@ func (x *SummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SummaryResponse.ProtoReflect.Descriptor instead.
-func (*SummaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SummaryRequest.ProtoReflect.Descriptor instead.
+func (*SummaryRequest) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SummaryResponse) GetError() string {
+func (x *SummaryRequest) GetQuery() string {
 	if x != nil {
-		return x.Error
+		return x.Query
 	}
 	return ""
 }
 
-func (x *SummaryResponse) GetSummary() []byte {
-	if x != nil {
-		return x.Summary
-	}
-	return nil
-}
-
-type PingRequest struct {
+type SummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ping string `protobuf:"bytes,1,opt,name=ping,proto3" json:"ping,omitempty"`
+	Error   string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Summary []byte `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *SummaryResponse) Reset() {
+	*x = SummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,13 +1480,13 @@ func (x *PingRequest) Reset() {
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *SummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*SummaryResponse) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
+func (x *SummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,30 +1498,40 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SummaryResponse.ProtoReflect.Descriptor instead.
+func (*SummaryResponse) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PingRequest) GetPing() string {
+func (x *SummaryResponse) GetError() string {
 	if x != nil {
-		return x.Ping
+		return x.Error
 	}
 	return ""
 }
 
-type PongResponse struct {
+func (x *SummaryResponse) GetSummary() []byte {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// SeriesRequest - summary metric by time. The metric is a summary JSON field name,
+// entry_types and decision_orgs need the key: entry type key or org.
+type SeriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error              string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	RegistryUpdateTime int64  `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
-	Pong               string `protobuf:"bytes,3,opt,name=pong,proto3" json:"pong,omitempty"`
+	Metric     string     `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Key        string     `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Range      *TimeRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	Resolution Resolution `protobuf:"varint,4,opt,name=resolution,proto3,enum=msg.Resolution" json:"resolution,omitempty"`
 }
 
-func (x *PongResponse) Reset() {
-	*x = PongResponse{}
+func (x *SeriesRequest) Reset() {
+	*x = SeriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,13 +1539,13 @@ func (x *PongResponse) Reset() {
 	}
 }
 
-func (x *PongResponse) String() string {
+func (x *SeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PongResponse) ProtoMessage() {}
+func (*SeriesRequest) ProtoMessage() {}
 
-func (x *PongResponse) ProtoReflect() protoreflect.Message {
+func (x *SeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -802,42 +1557,50 @@ func (x *PongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PongResponse.ProtoReflect.Descriptor instead.
-func (*PongResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeriesRequest.ProtoReflect.Descriptor instead.
+func (*SeriesRequest) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PongResponse) GetError() string {
+func (x *SeriesRequest) GetMetric() string {
 	if x != nil {
-		return x.Error
+		return x.Metric
 	}
 	return ""
 }
 
-func (x *PongResponse) GetRegistryUpdateTime() int64 {
+func (x *SeriesRequest) GetKey() string {
 	if x != nil {
-		return x.RegistryUpdateTime
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *PongResponse) GetPong() string {
+func (x *SeriesRequest) GetRange() *TimeRange {
 	if x != nil {
-		return x.Pong
+		return x.Range
 	}
-	return ""
+	return nil
 }
 
-type OrgRequest struct {
+func (x *SeriesRequest) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_DAILY
+}
+
+type SeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query uint64 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Error  string         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Points []*SeriesPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
 }
 
-func (x *OrgRequest) Reset() {
-	*x = OrgRequest{}
+func (x *SeriesResponse) Reset() {
+	*x = SeriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,13 +1608,13 @@ func (x *OrgRequest) Reset() {
 	}
 }
 
-func (x *OrgRequest) String() string {
+func (x *SeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgRequest) ProtoMessage() {}
+func (*SeriesResponse) ProtoMessage() {}
 
-func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+func (x *SeriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -863,28 +1626,39 @@ func (x *OrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
-func (*OrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeriesResponse.ProtoReflect.Descriptor instead.
+func (*SeriesResponse) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OrgRequest) GetQuery() uint64 {
+func (x *SeriesResponse) GetError() string {
 	if x != nil {
-		return x.Query
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type WithoutNoRequest struct {
+func (x *SeriesResponse) GetPoints() []*SeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// SeriesPoint - the last value of the bucket, added, updated and removed are summed.
+type SeriesPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Time       int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // bucket start, Moscow time
+	Value      int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	UpdateTime int64 `protobuf:"varint,3,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // registry update time of the last dump in the bucket
+	Dumps      int32 `protobuf:"varint,4,opt,name=dumps,proto3" json:"dumps,omitempty"`
 }
 
-func (x *WithoutNoRequest) Reset() {
-	*x = WithoutNoRequest{}
+func (x *SeriesPoint) Reset() {
+	*x = SeriesPoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,13 +1666,13 @@ func (x *WithoutNoRequest) Reset() {
 	}
 }
 
-func (x *WithoutNoRequest) String() string {
+func (x *SeriesPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithoutNoRequest) ProtoMessage() {}
+func (*SeriesPoint) ProtoMessage() {}
 
-func (x *WithoutNoRequest) ProtoReflect() protoreflect.Message {
+func (x *SeriesPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,36 +1684,49 @@ func (x *WithoutNoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithoutNoRequest.ProtoReflect.Descriptor instead.
-func (*WithoutNoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeriesPoint.ProtoReflect.Descriptor instead.
+func (*SeriesPoint) Descriptor() ([]byte, []int) {
 	return file_msg_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WithoutNoRequest) GetQuery() string {
+func (x *SeriesPoint) GetTime() int64 {
 	if x != nil {
-		return x.Query
+		return x.Time
 	}
-	return ""
+	return 0
 }
 
-type Content struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id                 int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RegistryUpdateTime int64  `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
-	BlockType          int32  `protobuf:"varint,3,opt,name=blockType,proto3" json:"blockType,omitempty"`
-	Ip4                uint32 `protobuf:"varint,4,opt,name=ip4,proto3" json:"ip4,omitempty"`
-	Ip6                []byte `protobuf:"bytes,5,opt,name=ip6,proto3" json:"ip6,omitempty"`
-	Domain             string `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
-	Url                string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
-	Aggr               string `protobuf:"bytes,8,opt,name=aggr,proto3" json:"aggr,omitempty"`
-	Pack               []byte `protobuf:"bytes,9,opt,name=pack,proto3" json:"pack,omitempty"`
+func (x *SeriesPoint) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
 
-func (x *Content) Reset() {
-	*x = Content{}
+func (x *SeriesPoint) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *SeriesPoint) GetDumps() int32 {
+	if x != nil {
+		return x.Dumps
+	}
+	return 0
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ping string `protobuf:"bytes,1,opt,name=ping,proto3" json:"ping,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -947,311 +1734,3980 @@ func (x *Content) Reset() {
 	}
 }
 
-func (x *Content) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Content) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *Content) ProtoReflect() protoreflect.Message {
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Content.ProtoReflect.Descriptor instead.
-func (*Content) Descriptor() ([]byte, []int) {
-	return file_msg_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *Content) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Content) GetRegistryUpdateTime() int64 {
-	if x != nil {
-		return x.RegistryUpdateTime
-	}
-	return 0
-}
-
-func (x *Content) GetBlockType() int32 {
-	if x != nil {
-		return x.BlockType
-	}
-	return 0
-}
-
-func (x *Content) GetIp4() uint32 {
-	if x != nil {
-		return x.Ip4
-	}
-	return 0
-}
-
-func (x *Content) GetIp6() []byte {
-	if x != nil {
-		return x.Ip6
-	}
-	return nil
-}
-
-func (x *Content) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
-}
-
-func (x *Content) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *Content) GetAggr() string {
-	if x != nil {
-		return x.Aggr
-	}
-	return ""
-}
-
-func (x *Content) GetPack() []byte {
-	if x != nil {
-		return x.Pack
-	}
-	return nil
-}
-
-var File_msg_proto protoreflect.FileDescriptor
-
-var file_msg_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x28, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x23, 0x0a, 0x0b, 0x49, 0x50,
-	0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0x23, 0x0a, 0x0b, 0x49, 0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x22, 0x22, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x25, 0x0a, 0x0d, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0x3f, 0x0a, 0x0d, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
-	0x22, 0x27, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x2b, 0x0a, 0x13, 0x54, 0x65, 0x78,
-	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x29, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x49, 0x50, 0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x22, 0x29, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x36, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x28, 0x0a, 0x10,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x26, 0x0a,
-	0x0e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x0f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x68, 0x0a, 0x0c, 0x50,
-	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x6f, 0x6e, 0x67, 0x22, 0x22, 0x0a, 0x0a, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x28, 0x0a, 0x10, 0x57, 0x69, 0x74,
-	0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x22, 0xdd, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x69, 0x70, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x70, 0x34, 0x12,
-	0x10, 0x0a, 0x03, 0x69, 0x70, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x70,
-	0x36, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x67, 0x67, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x67, 0x67, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70,
-	0x61, 0x63, 0x6b, 0x32, 0xf3, 0x06, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3d, 0x0a,
-	0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x76, 0x34, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x49, 0x50, 0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d,
-	0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x76, 0x36, 0x12,
-	0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x49, 0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x52, 0x4c, 0x12, 0x0f, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x43, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x65, 0x78, 0x74,
-	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x34, 0x12, 0x16, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x36, 0x12, 0x16, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x12, 0x2e, 0x6d,
-	0x73, 0x67, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
-	0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x50, 0x69,
-	0x6e, 0x67, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4f, 0x72, 0x67, 0x12, 0x0f, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x12, 0x15, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x75, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x73, 0x68, 0x65, 0x72, 0x32, 0x2f, 0x75,
-	0x32, 0x63, 0x6b, 0x64, 0x75, 0x6d, 0x70, 0x2f, 0x6d, 0x73, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_msg_proto_rawDescOnce sync.Once
-	file_msg_proto_rawDescData = file_msg_proto_rawDesc
-)
-
-func file_msg_proto_rawDescGZIP() []byte {
-	file_msg_proto_rawDescOnce.Do(func() {
-		file_msg_proto_rawDescData = protoimpl.X.CompressGZIP(file_msg_proto_rawDescData)
-	})
-	return file_msg_proto_rawDescData
-}
-
-var file_msg_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_msg_proto_goTypes = []interface{}{
-	(*ContentIDRequest)(nil),    // 0: msg.ContentIDRequest
-	(*IPv4Request)(nil),         // 1: msg.IPv4Request
-	(*IPv6Request)(nil),         // 2: msg.IPv6Request
-	(*URLRequest)(nil),          // 3: msg.URLRequest
-	(*DomainRequest)(nil),       // 4: msg.DomainRequest
-	(*SuffixRequest)(nil),       // 5: msg.SuffixRequest
-	(*DecisionRequest)(nil),     // 6: msg.DecisionRequest
-	(*TextDecisionRequest)(nil), // 7: msg.TextDecisionRequest
-	(*SubnetIPv4Request)(nil),   // 8: msg.SubnetIPv4Request
-	(*SubnetIPv6Request)(nil),   // 9: msg.SubnetIPv6Request
-	(*EntryTypeRequest)(nil),    // 10: msg.EntryTypeRequest
-	(*SearchResponse)(nil),      // 11: msg.SearchResponse
-	(*SummaryRequest)(nil),      // 12: msg.SummaryRequest
-	(*SummaryResponse)(nil),     // 13: msg.SummaryResponse
-	(*PingRequest)(nil),         // 14: msg.PingRequest
-	(*PongResponse)(nil),        // 15: msg.PongResponse
-	(*OrgRequest)(nil),          // 16: msg.OrgRequest
-	(*WithoutNoRequest)(nil),    // 17: msg.WithoutNoRequest
-	(*Content)(nil),             // 18: msg.Content
-}
-var file_msg_proto_depIdxs = []int32{
-	18, // 0: msg.SearchResponse.results:type_name -> msg.Content
-	0,  // 1: msg.Check.SearchContentID:input_type -> msg.ContentIDRequest
-	1,  // 2: msg.Check.SearchIPv4:input_type -> msg.IPv4Request
-	2,  // 3: msg.Check.SearchIPv6:input_type -> msg.IPv6Request
-	3,  // 4: msg.Check.SearchURL:input_type -> msg.URLRequest
-	4,  // 5: msg.Check.SearchDomain:input_type -> msg.DomainRequest
-	6,  // 6: msg.Check.SearchDecision:input_type -> msg.DecisionRequest
-	7,  // 7: msg.Check.SearchTextDecision:input_type -> msg.TextDecisionRequest
-	8,  // 8: msg.Check.SearchSubnetIPv4:input_type -> msg.SubnetIPv4Request
-	9,  // 9: msg.Check.SearchSubnetIPv6:input_type -> msg.SubnetIPv6Request
-	5,  // 10: msg.Check.SearchDomainSuffix:input_type -> msg.SuffixRequest
-	10, // 11: msg.Check.SearchEntryType:input_type -> msg.EntryTypeRequest
-	12, // 12: msg.Check.Summary:input_type -> msg.SummaryRequest
-	14, // 13: msg.Check.Ping:input_type -> msg.PingRequest
-	16, // 14: msg.Check.SearchOrg:input_type -> msg.OrgRequest
-	17, // 15: msg.Check.SearchWithoutNo:input_type -> msg.WithoutNoRequest
-	11, // 16: msg.Check.SearchContentID:output_type -> msg.SearchResponse
-	11, // 17: msg.Check.SearchIPv4:output_type -> msg.SearchResponse
-	11, // 18: msg.Check.SearchIPv6:output_type -> msg.SearchResponse
-	11, // 19: msg.Check.SearchURL:output_type -> msg.SearchResponse
-	11, // 20: msg.Check.SearchDomain:output_type -> msg.SearchResponse
-	11, // 21: msg.Check.SearchDecision:output_type -> msg.SearchResponse
-	11, // 22: msg.Check.SearchTextDecision:output_type -> msg.SearchResponse
-	11, // 23: msg.Check.SearchSubnetIPv4:output_type -> msg.SearchResponse
-	11, // 24: msg.Check.SearchSubnetIPv6:output_type -> msg.SearchResponse
-	11, // 25: msg.Check.SearchDomainSuffix:output_type -> msg.SearchResponse
-	11, // 26: msg.Check.SearchEntryType:output_type -> msg.SearchResponse
-	13, // 27: msg.Check.Summary:output_type -> msg.SummaryResponse
-	15, // 28: msg.Check.Ping:output_type -> msg.PongResponse
-	11, // 29: msg.Check.SearchOrg:output_type -> msg.SearchResponse
-	11, // 30: msg.Check.SearchWithoutNo:output_type -> msg.SearchResponse
-	16, // [16:31] is the sub-list for method output_type
-	1,  // [1:16] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_msg_proto_init() }
-func file_msg_proto_init() {
-	if File_msg_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_msg_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContentIDRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PingRequest) GetPing() string {
+	if x != nil {
+		return x.Ping
+	}
+	return ""
+}
+
+type PongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	RegistryUpdateTime int64  `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	Pong               string `protobuf:"bytes,3,opt,name=pong,proto3" json:"pong,omitempty"`
+}
+
+func (x *PongResponse) Reset() {
+	*x = PongResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PongResponse) ProtoMessage() {}
+
+func (x *PongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PongResponse.ProtoReflect.Descriptor instead.
+func (*PongResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PongResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PongResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *PongResponse) GetPong() string {
+	if x != nil {
+		return x.Pong
+	}
+	return ""
+}
+
+// QueryRequest - clauses are ORed.
+type QueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clauses        []*QueryClause `protobuf:"bytes,1,rep,name=clauses,proto3" json:"clauses,omitempty"`
+	Page           *Page          `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool           `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64          `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryRequest) GetClauses() []*QueryClause {
+	if x != nil {
+		return x.Clauses
+	}
+	return nil
+}
+
+func (x *QueryRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *QueryRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+// QueryClause - set conditions are ANDed, values inside a list are ORed.
+type QueryClause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orgs         []string   `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	EntryTypes   []string   `protobuf:"bytes,2,rep,name=entryTypes,proto3" json:"entryTypes,omitempty"`
+	BlockTypes   []int32    `protobuf:"varint,3,rep,packed,name=blockTypes,proto3" json:"blockTypes,omitempty"`
+	DecisionDate *TimeRange `protobuf:"bytes,4,opt,name=decisionDate,proto3" json:"decisionDate,omitempty"`
+	IncludeTime  *TimeRange `protobuf:"bytes,5,opt,name=includeTime,proto3" json:"includeTime,omitempty"`
+	HasIPs       Presence   `protobuf:"varint,6,opt,name=hasIPs,proto3,enum=msg.Presence" json:"hasIPs,omitempty"`
+	HasURLs      Presence   `protobuf:"varint,7,opt,name=hasURLs,proto3,enum=msg.Presence" json:"hasURLs,omitempty"`
+	HasDomains   Presence   `protobuf:"varint,8,opt,name=hasDomains,proto3,enum=msg.Presence" json:"hasDomains,omitempty"`
+}
+
+func (x *QueryClause) Reset() {
+	*x = QueryClause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryClause) ProtoMessage() {}
+
+func (x *QueryClause) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryClause.ProtoReflect.Descriptor instead.
+func (*QueryClause) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryClause) GetOrgs() []string {
+	if x != nil {
+		return x.Orgs
+	}
+	return nil
+}
+
+func (x *QueryClause) GetEntryTypes() []string {
+	if x != nil {
+		return x.EntryTypes
+	}
+	return nil
+}
+
+func (x *QueryClause) GetBlockTypes() []int32 {
+	if x != nil {
+		return x.BlockTypes
+	}
+	return nil
+}
+
+func (x *QueryClause) GetDecisionDate() *TimeRange {
+	if x != nil {
+		return x.DecisionDate
+	}
+	return nil
+}
+
+func (x *QueryClause) GetIncludeTime() *TimeRange {
+	if x != nil {
+		return x.IncludeTime
+	}
+	return nil
+}
+
+func (x *QueryClause) GetHasIPs() Presence {
+	if x != nil {
+		return x.HasIPs
+	}
+	return Presence_PRESENCE_ANY
+}
+
+func (x *QueryClause) GetHasURLs() Presence {
+	if x != nil {
+		return x.HasURLs
+	}
+	return Presence_PRESENCE_ANY
+}
+
+func (x *QueryClause) GetHasDomains() Presence {
+	if x != nil {
+		return x.HasDomains
+	}
+	return Presence_PRESENCE_ANY
+}
+
+// TimeRange - unix time, from is inclusive, to is exclusive, zero is unbounded.
+type TimeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TimeRange) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TimeRange) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// Facet - number of matched records for every value of the dimension.
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dimension string           `protobuf:"bytes,1,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Counts    map[string]int32 `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Facet) GetDimension() string {
+	if x != nil {
+		return x.Dimension
+	}
+	return ""
+}
+
+func (x *Facet) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// TimeRequest - records or elements by time, results are ordered by time.
+type TimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          *TimeRange `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Field          TimeField  `protobuf:"varint,2,opt,name=field,proto3,enum=msg.TimeField" json:"field,omitempty"`
+	Page           *Page      `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool       `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64      `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
+}
+
+func (x *TimeRequest) Reset() {
+	*x = TimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRequest) ProtoMessage() {}
+
+func (x *TimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
+func (*TimeRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimeRequest) GetQuery() *TimeRange {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *TimeRequest) GetField() TimeField {
+	if x != nil {
+		return x.Field
+	}
+	return TimeField_TIME_INCLUDE
+}
+
+func (x *TimeRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *TimeRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *TimeRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+// RecordHistoryRequest - versions of the content ID.
+type RecordHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query int32 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *RecordHistoryRequest) Reset() {
+	*x = RecordHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordHistoryRequest) ProtoMessage() {}
+
+func (x *RecordHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RecordHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecordHistoryRequest) GetQuery() int32 {
+	if x != nil {
+		return x.Query
+	}
+	return 0
+}
+
+type RecordHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error    string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Query    int32            `protobuf:"varint,2,opt,name=query,proto3" json:"query,omitempty"`
+	Versions []*RecordVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"` // oldest first
+}
+
+func (x *RecordHistoryResponse) Reset() {
+	*x = RecordHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordHistoryResponse) ProtoMessage() {}
+
+func (x *RecordHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RecordHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecordHistoryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RecordHistoryResponse) GetQuery() int32 {
+	if x != nil {
+		return x.Query
+	}
+	return 0
+}
+
+func (x *RecordHistoryResponse) GetVersions() []*RecordVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// RecordVersion - version of the record valid from since till until.
+type RecordVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Since      int64          `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"` // registry update time of the dump the version appeared in
+	Until      int64          `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"` // registry update time of the dump the version disappeared from, 0 if it is current
+	RecordHash uint64         `protobuf:"varint,3,opt,name=recordHash,proto3" json:"recordHash,omitempty"`
+	Record     *ContentRecord `protobuf:"bytes,4,opt,name=record,proto3" json:"record,omitempty"`
+	Diff       *RecordDiff    `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"` // changes against the previous version, empty for the first one
+}
+
+func (x *RecordVersion) Reset() {
+	*x = RecordVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordVersion) ProtoMessage() {}
+
+func (x *RecordVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordVersion.ProtoReflect.Descriptor instead.
+func (*RecordVersion) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RecordVersion) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *RecordVersion) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *RecordVersion) GetRecordHash() uint64 {
+	if x != nil {
+		return x.RecordHash
+	}
+	return 0
+}
+
+func (x *RecordVersion) GetRecord() *ContentRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *RecordVersion) GetDiff() *RecordDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// RecordDiff - field level changes of the record.
+type RecordDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip4Added         []string  `protobuf:"bytes,1,rep,name=ip4Added,proto3" json:"ip4Added,omitempty"`
+	Ip4Removed       []string  `protobuf:"bytes,2,rep,name=ip4Removed,proto3" json:"ip4Removed,omitempty"`
+	Ip6Added         []string  `protobuf:"bytes,3,rep,name=ip6Added,proto3" json:"ip6Added,omitempty"`
+	Ip6Removed       []string  `protobuf:"bytes,4,rep,name=ip6Removed,proto3" json:"ip6Removed,omitempty"`
+	Subnet4Added     []string  `protobuf:"bytes,5,rep,name=subnet4Added,proto3" json:"subnet4Added,omitempty"`
+	Subnet4Removed   []string  `protobuf:"bytes,6,rep,name=subnet4Removed,proto3" json:"subnet4Removed,omitempty"`
+	Subnet6Added     []string  `protobuf:"bytes,7,rep,name=subnet6Added,proto3" json:"subnet6Added,omitempty"`
+	Subnet6Removed   []string  `protobuf:"bytes,8,rep,name=subnet6Removed,proto3" json:"subnet6Removed,omitempty"`
+	DomainAdded      []string  `protobuf:"bytes,9,rep,name=domainAdded,proto3" json:"domainAdded,omitempty"`
+	DomainRemoved    []string  `protobuf:"bytes,10,rep,name=domainRemoved,proto3" json:"domainRemoved,omitempty"`
+	UrlAdded         []string  `protobuf:"bytes,11,rep,name=urlAdded,proto3" json:"urlAdded,omitempty"`
+	UrlRemoved       []string  `protobuf:"bytes,12,rep,name=urlRemoved,proto3" json:"urlRemoved,omitempty"`
+	DecisionChanged  bool      `protobuf:"varint,13,opt,name=decisionChanged,proto3" json:"decisionChanged,omitempty"`
+	PrevDecision     *Decision `protobuf:"bytes,14,opt,name=prevDecision,proto3" json:"prevDecision,omitempty"`
+	EntryTypeChanged bool      `protobuf:"varint,15,opt,name=entryTypeChanged,proto3" json:"entryTypeChanged,omitempty"`
+	PrevEntryType    int32     `protobuf:"varint,16,opt,name=prevEntryType,proto3" json:"prevEntryType,omitempty"`
+	BlockTypeChanged bool      `protobuf:"varint,17,opt,name=blockTypeChanged,proto3" json:"blockTypeChanged,omitempty"`
+	PrevBlockType    string    `protobuf:"bytes,18,opt,name=prevBlockType,proto3" json:"prevBlockType,omitempty"`
+	Reappeared       bool      `protobuf:"varint,19,opt,name=reappeared,proto3" json:"reappeared,omitempty"` // the record was removed before this version
+}
+
+func (x *RecordDiff) Reset() {
+	*x = RecordDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDiff) ProtoMessage() {}
+
+func (x *RecordDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDiff.ProtoReflect.Descriptor instead.
+func (*RecordDiff) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecordDiff) GetIp4Added() []string {
+	if x != nil {
+		return x.Ip4Added
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetIp4Removed() []string {
+	if x != nil {
+		return x.Ip4Removed
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetIp6Added() []string {
+	if x != nil {
+		return x.Ip6Added
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetIp6Removed() []string {
+	if x != nil {
+		return x.Ip6Removed
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetSubnet4Added() []string {
+	if x != nil {
+		return x.Subnet4Added
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetSubnet4Removed() []string {
+	if x != nil {
+		return x.Subnet4Removed
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetSubnet6Added() []string {
+	if x != nil {
+		return x.Subnet6Added
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetSubnet6Removed() []string {
+	if x != nil {
+		return x.Subnet6Removed
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetDomainAdded() []string {
+	if x != nil {
+		return x.DomainAdded
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetDomainRemoved() []string {
+	if x != nil {
+		return x.DomainRemoved
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetUrlAdded() []string {
+	if x != nil {
+		return x.UrlAdded
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetUrlRemoved() []string {
+	if x != nil {
+		return x.UrlRemoved
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetDecisionChanged() bool {
+	if x != nil {
+		return x.DecisionChanged
+	}
+	return false
+}
+
+func (x *RecordDiff) GetPrevDecision() *Decision {
+	if x != nil {
+		return x.PrevDecision
+	}
+	return nil
+}
+
+func (x *RecordDiff) GetEntryTypeChanged() bool {
+	if x != nil {
+		return x.EntryTypeChanged
+	}
+	return false
+}
+
+func (x *RecordDiff) GetPrevEntryType() int32 {
+	if x != nil {
+		return x.PrevEntryType
+	}
+	return 0
+}
+
+func (x *RecordDiff) GetBlockTypeChanged() bool {
+	if x != nil {
+		return x.BlockTypeChanged
+	}
+	return false
+}
+
+func (x *RecordDiff) GetPrevBlockType() string {
+	if x != nil {
+		return x.PrevBlockType
+	}
+	return ""
+}
+
+func (x *RecordDiff) GetReappeared() bool {
+	if x != nil {
+		return x.Reappeared
+	}
+	return false
+}
+
+type OrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          uint64 `protobuf:"varint,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
+}
+
+func (x *OrgRequest) Reset() {
+	*x = OrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgRequest) ProtoMessage() {}
+
+func (x *OrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgRequest.ProtoReflect.Descriptor instead.
+func (*OrgRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OrgRequest) GetQuery() uint64 {
+	if x != nil {
+		return x.Query
+	}
+	return 0
+}
+
+func (x *OrgRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *OrgRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *OrgRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+type WithoutNoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page           *Page  `protobuf:"bytes,10,opt,name=page,proto3" json:"page,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,11,opt,name=includeRemoved,proto3" json:"includeRemoved,omitempty"`
+	AsOf           int64  `protobuf:"varint,12,opt,name=asOf,proto3" json:"asOf,omitempty"` // unix time, search the registry as it was then
+}
+
+func (x *WithoutNoRequest) Reset() {
+	*x = WithoutNoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithoutNoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithoutNoRequest) ProtoMessage() {}
+
+func (x *WithoutNoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithoutNoRequest.ProtoReflect.Descriptor instead.
+func (*WithoutNoRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WithoutNoRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *WithoutNoRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *WithoutNoRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *WithoutNoRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+// LintRequest - data quality report of the current dump.
+type LintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvalidOnly bool `protobuf:"varint,1,opt,name=invalidOnly,proto3" json:"invalidOnly,omitempty"` // only records with quarantined elements
+}
+
+func (x *LintRequest) Reset() {
+	*x = LintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintRequest) ProtoMessage() {}
+
+func (x *LintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintRequest.ProtoReflect.Descriptor instead.
+func (*LintRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LintRequest) GetInvalidOnly() bool {
+	if x != nil {
+		return x.InvalidOnly
+	}
+	return false
+}
+
+type LintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	RegistryUpdateTime int64         `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	Valid              int32         `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Repaired           int32         `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Invalid            int32         `protobuf:"varint,5,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Records            []*LintRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"` // ordered by content ID
+}
+
+func (x *LintResponse) Reset() {
+	*x = LintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintResponse) ProtoMessage() {}
+
+func (x *LintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintResponse.ProtoReflect.Descriptor instead.
+func (*LintResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LintResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LintResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *LintResponse) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *LintResponse) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+func (x *LintResponse) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *LintResponse) GetRecords() []*LintRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// LintRecord - repaired and invalid elements of the record.
+type LintRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Elements []*LintElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *LintRecord) Reset() {
+	*x = LintRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintRecord) ProtoMessage() {}
+
+func (x *LintRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintRecord.ProtoReflect.Descriptor instead.
+func (*LintRecord) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LintRecord) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LintRecord) GetElements() []*LintElement {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+// LintElement - invalid elements are quarantined: kept out of the record and the indexes.
+type LintElement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Element  string     `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"` // ip, ipv6, ipSubnet, ipv6Subnet, domain, url
+	Value    string     `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`     // as it is in the dump
+	Status   LintStatus `protobuf:"varint,3,opt,name=status,proto3,enum=msg.LintStatus" json:"status,omitempty"`
+	Reason   string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Repaired string     `protobuf:"bytes,5,opt,name=repaired,proto3" json:"repaired,omitempty"` // the value used instead, empty for invalid elements
+}
+
+func (x *LintElement) Reset() {
+	*x = LintElement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintElement) ProtoMessage() {}
+
+func (x *LintElement) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintElement.ProtoReflect.Descriptor instead.
+func (*LintElement) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LintElement) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *LintElement) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LintElement) GetStatus() LintStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LintStatus_LINT_VALID
+}
+
+func (x *LintElement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LintElement) GetRepaired() string {
+	if x != nil {
+		return x.Repaired
+	}
+	return ""
+}
+
+// ConsistencyRequest - findings of the structural checks, refreshed after every dump.
+type ConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks []ConsistencyCheck `protobuf:"varint,1,rep,packed,name=checks,proto3,enum=msg.ConsistencyCheck" json:"checks,omitempty"` // empty is all checks
+	Id     int32              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                                          // content ID, 0 is all records
+}
+
+func (x *ConsistencyRequest) Reset() {
+	*x = ConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyRequest) ProtoMessage() {}
+
+func (x *ConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*ConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConsistencyRequest) GetChecks() []ConsistencyCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *ConsistencyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConsistencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	RegistryUpdateTime int64            `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	Counts             map[string]int32 `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // findings by check of the whole report
+	Findings           []*Finding       `protobuf:"bytes,4,rep,name=findings,proto3" json:"findings,omitempty"`                                                                                      // ordered by content ID
+}
+
+func (x *ConsistencyResponse) Reset() {
+	*x = ConsistencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyResponse) ProtoMessage() {}
+
+func (x *ConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*ConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConsistencyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConsistencyResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *ConsistencyResponse) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ConsistencyResponse) GetFindings() []*Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type Finding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Check  ConsistencyCheck `protobuf:"varint,2,opt,name=check,proto3,enum=msg.ConsistencyCheck" json:"check,omitempty"`
+	Detail string           `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"` // the element or the attribute
+}
+
+func (x *Finding) Reset() {
+	*x = Finding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Finding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Finding) ProtoMessage() {}
+
+func (x *Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Finding.ProtoReflect.Descriptor instead.
+func (*Finding) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Finding) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Finding) GetCheck() ConsistencyCheck {
+	if x != nil {
+		return x.Check
+	}
+	return ConsistencyCheck_CHECK_NONE
+}
+
+func (x *Finding) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// AuditRequest - rebuilds the indexes from the records and compares them with the live ones.
+type AuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"` // replace the live indexes with the rebuilt ones if they differ
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AuditRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type AuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	RegistryUpdateTime int64            `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	Total              int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Counts             map[string]int32 `protobuf:"bytes,4,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // findings by index
+	Findings           []*AuditFinding  `protobuf:"bytes,5,rep,name=findings,proto3" json:"findings,omitempty"`                                                                                      // the first findings only, see total
+	Repaired           bool             `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Duration           int64            `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"` // milliseconds
+}
+
+func (x *AuditResponse) Reset() {
+	*x = AuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResponse) ProtoMessage() {}
+
+func (x *AuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResponse.ProtoReflect.Descriptor instead.
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AuditResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *AuditResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AuditResponse) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *AuditResponse) GetFindings() []*AuditFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *AuditResponse) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *AuditResponse) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type AuditFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   string       `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Key     string       `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Id      int32        `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // 0 for netTree networks
+	Problem AuditProblem `protobuf:"varint,4,opt,name=problem,proto3,enum=msg.AuditProblem" json:"problem,omitempty"`
+}
+
+func (x *AuditFinding) Reset() {
+	*x = AuditFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditFinding) ProtoMessage() {}
+
+func (x *AuditFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditFinding.ProtoReflect.Descriptor instead.
+func (*AuditFinding) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AuditFinding) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AuditFinding) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditFinding) GetProblem() AuditProblem {
+	if x != nil {
+		return x.Problem
+	}
+	return AuditProblem_AUDIT_NONE
+}
+
+// RefreshRequest - fetches and parses the last dump now, as the poll does.
+type RefreshRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{41}
+}
+
+// ReloadRequest - parses the local dump file, zipped or plain.
+type ReloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (x *ReloadRequest) Reset() {
+	*x = ReloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadRequest) ProtoMessage() {}
+
+func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadRequest.ProtoReflect.Descriptor instead.
+func (*ReloadRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReloadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// PauseRequest - pauses or resumes the dump polling.
+type PauseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRequest) ProtoMessage() {}
+
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PauseRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type StatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{44}
+}
+
+// StatusResponse - the polling state, the current dump and the last attempt.
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Paused             bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Busy               bool   `protobuf:"varint,3,opt,name=busy,proto3" json:"busy,omitempty"`               // a refresh or a reload is running
+	CurrentID          string `protobuf:"bytes,4,opt,name=currentID,proto3" json:"currentID,omitempty"`      // the last applied dump
+	CurrentTime        int64  `protobuf:"varint,5,opt,name=currentTime,proto3" json:"currentTime,omitempty"` // when it was applied
+	RegistryUpdateTime int64  `protobuf:"varint,6,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	AttemptID          string `protobuf:"bytes,7,opt,name=attemptID,proto3" json:"attemptID,omitempty"`         // dump ID or file name of the last refresh or reload
+	AttemptSource      string `protobuf:"bytes,8,opt,name=attemptSource,proto3" json:"attemptSource,omitempty"` // poll, refresh, reload, upload or watch
+	AttemptTime        int64  `protobuf:"varint,9,opt,name=attemptTime,proto3" json:"attemptTime,omitempty"`
+	AttemptError       string `protobuf:"bytes,10,opt,name=attemptError,proto3" json:"attemptError,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *StatusResponse) GetBusy() bool {
+	if x != nil {
+		return x.Busy
+	}
+	return false
+}
+
+func (x *StatusResponse) GetCurrentID() string {
+	if x != nil {
+		return x.CurrentID
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetCurrentTime() int64 {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetAttemptID() string {
+	if x != nil {
+		return x.AttemptID
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetAttemptSource() string {
+	if x != nil {
+		return x.AttemptSource
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetAttemptTime() int64 {
+	if x != nil {
+		return x.AttemptTime
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetAttemptError() string {
+	if x != nil {
+		return x.AttemptError
+	}
+	return ""
+}
+
+type ProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProgressRequest) Reset() {
+	*x = ProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressRequest) ProtoMessage() {}
+
+func (x *ProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressRequest.ProtoReflect.Descriptor instead.
+func (*ProgressRequest) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{46}
+}
+
+// ProgressResponse - progress of the running or the last parse.
+type ProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error      string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Running    bool   `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Started    int64  `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Finished   int64  `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+	BytesRead  int64  `protobuf:"varint,5,opt,name=bytesRead,proto3" json:"bytesRead,omitempty"`
+	BytesTotal int64  `protobuf:"varint,6,opt,name=bytesTotal,proto3" json:"bytesTotal,omitempty"` // 0 if unknown
+	Records    int32  `protobuf:"varint,7,opt,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ProgressResponse) Reset() {
+	*x = ProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressResponse) ProtoMessage() {}
+
+func (x *ProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressResponse.ProtoReflect.Descriptor instead.
+func (*ProgressResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProgressResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProgressResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ProgressResponse) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *ProgressResponse) GetFinished() int64 {
+	if x != nil {
+		return x.Finished
+	}
+	return 0
+}
+
+func (x *ProgressResponse) GetBytesRead() int64 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *ProgressResponse) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *ProgressResponse) GetRecords() int32 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+// UploadChunk - the part of the uploaded dump.zip, the chunks are concatenated in order.
+type UploadChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"` // hex digest of the whole archive, optional, checked if set in any chunk
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`     // dump ID or file name for the status, optional
+}
+
+func (x *UploadChunk) Reset() {
+	*x = UploadChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunk) ProtoMessage() {}
+
+func (x *UploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunk.ProtoReflect.Descriptor instead.
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadChunk) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UploadResponse - the outcome of the upload, the summary of the parsed dump as in SummaryResponse.
+type UploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error              string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Size               int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256             string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	RegistryUpdateTime int64  `protobuf:"varint,4,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	Summary            []byte `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *UploadResponse) Reset() {
+	*x = UploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadResponse) ProtoMessage() {}
+
+func (x *UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UploadResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UploadResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadResponse) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *UploadResponse) GetSummary() []byte {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type Content struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RegistryUpdateTime int64          `protobuf:"varint,2,opt,name=registryUpdateTime,proto3" json:"registryUpdateTime,omitempty"`
+	BlockType          int32          `protobuf:"varint,3,opt,name=blockType,proto3" json:"blockType,omitempty"`
+	Ip4                uint32         `protobuf:"varint,4,opt,name=ip4,proto3" json:"ip4,omitempty"`
+	Ip6                []byte         `protobuf:"bytes,5,opt,name=ip6,proto3" json:"ip6,omitempty"`
+	Domain             string         `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	Url                string         `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Aggr               string         `protobuf:"bytes,8,opt,name=aggr,proto3" json:"aggr,omitempty"`
+	Pack               []byte         `protobuf:"bytes,9,opt,name=pack,proto3" json:"pack,omitempty"`
+	Match              MatchReason    `protobuf:"varint,10,opt,name=match,proto3,enum=msg.MatchReason" json:"match,omitempty"`
+	MatchKey           string         `protobuf:"bytes,11,opt,name=matchKey,proto3" json:"matchKey,omitempty"`
+	Record             *ContentRecord `protobuf:"bytes,12,opt,name=record,proto3" json:"record,omitempty"`
+	Removed            bool           `protobuf:"varint,13,opt,name=removed,proto3" json:"removed,omitempty"`
+	RemovedTime        int64          `protobuf:"varint,14,opt,name=removedTime,proto3" json:"removedTime,omitempty"`
+}
+
+func (x *Content) Reset() {
+	*x = Content{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Content) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Content) ProtoMessage() {}
+
+func (x *Content) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Content.ProtoReflect.Descriptor instead.
+func (*Content) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Content) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Content) GetRegistryUpdateTime() int64 {
+	if x != nil {
+		return x.RegistryUpdateTime
+	}
+	return 0
+}
+
+func (x *Content) GetBlockType() int32 {
+	if x != nil {
+		return x.BlockType
+	}
+	return 0
+}
+
+func (x *Content) GetIp4() uint32 {
+	if x != nil {
+		return x.Ip4
+	}
+	return 0
+}
+
+func (x *Content) GetIp6() []byte {
+	if x != nil {
+		return x.Ip6
+	}
+	return nil
+}
+
+func (x *Content) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Content) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Content) GetAggr() string {
+	if x != nil {
+		return x.Aggr
+	}
+	return ""
+}
+
+func (x *Content) GetPack() []byte {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+func (x *Content) GetMatch() MatchReason {
+	if x != nil {
+		return x.Match
+	}
+	return MatchReason_MATCH_NONE
+}
+
+func (x *Content) GetMatchKey() string {
+	if x != nil {
+		return x.MatchKey
+	}
+	return ""
+}
+
+func (x *Content) GetRecord() *ContentRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *Content) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *Content) GetRemovedTime() int64 {
+	if x != nil {
+		return x.RemovedTime
+	}
+	return 0
+}
+
+type ContentRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryType    int32          `protobuf:"varint,2,opt,name=entryType,proto3" json:"entryType,omitempty"`
+	EntryTypeKey string         `protobuf:"bytes,3,opt,name=entryTypeKey,proto3" json:"entryTypeKey,omitempty"`
+	UrgencyType  int32          `protobuf:"varint,4,opt,name=urgencyType,proto3" json:"urgencyType,omitempty"`
+	Decision     *Decision      `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	IncludeTime  int64          `protobuf:"varint,6,opt,name=includeTime,proto3" json:"includeTime,omitempty"`
+	Ts           int64          `protobuf:"varint,7,opt,name=ts,proto3" json:"ts,omitempty"`
+	BlockType    string         `protobuf:"bytes,8,opt,name=blockType,proto3" json:"blockType,omitempty"`
+	Hash         string         `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+	Urls         []*TimedString `protobuf:"bytes,10,rep,name=urls,proto3" json:"urls,omitempty"`
+	Domains      []*TimedString `protobuf:"bytes,11,rep,name=domains,proto3" json:"domains,omitempty"`
+	Ip4          []*TimedIPv4   `protobuf:"bytes,12,rep,name=ip4,proto3" json:"ip4,omitempty"`
+	Ip6          []*TimedIPv6   `protobuf:"bytes,13,rep,name=ip6,proto3" json:"ip6,omitempty"`
+	Subnet4      []*TimedString `protobuf:"bytes,14,rep,name=subnet4,proto3" json:"subnet4,omitempty"`
+	Subnet6      []*TimedString `protobuf:"bytes,15,rep,name=subnet6,proto3" json:"subnet6,omitempty"`
+	HttpsBlock   int32          `protobuf:"varint,16,opt,name=httpsBlock,proto3" json:"httpsBlock,omitempty"`
+	RecordHash   uint64         `protobuf:"varint,17,opt,name=recordHash,proto3" json:"recordHash,omitempty"`
+}
+
+func (x *ContentRecord) Reset() {
+	*x = ContentRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentRecord) ProtoMessage() {}
+
+func (x *ContentRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentRecord.ProtoReflect.Descriptor instead.
+func (*ContentRecord) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ContentRecord) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetEntryType() int32 {
+	if x != nil {
+		return x.EntryType
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetEntryTypeKey() string {
+	if x != nil {
+		return x.EntryTypeKey
+	}
+	return ""
+}
+
+func (x *ContentRecord) GetUrgencyType() int32 {
+	if x != nil {
+		return x.UrgencyType
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetDecision() *Decision {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetIncludeTime() int64 {
+	if x != nil {
+		return x.IncludeTime
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetBlockType() string {
+	if x != nil {
+		return x.BlockType
+	}
+	return ""
+}
+
+func (x *ContentRecord) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ContentRecord) GetUrls() []*TimedString {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetDomains() []*TimedString {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetIp4() []*TimedIPv4 {
+	if x != nil {
+		return x.Ip4
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetIp6() []*TimedIPv6 {
+	if x != nil {
+		return x.Ip6
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetSubnet4() []*TimedString {
+	if x != nil {
+		return x.Subnet4
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetSubnet6() []*TimedString {
+	if x != nil {
+		return x.Subnet6
+	}
+	return nil
+}
+
+func (x *ContentRecord) GetHttpsBlock() int32 {
+	if x != nil {
+		return x.HttpsBlock
+	}
+	return 0
+}
+
+func (x *ContentRecord) GetRecordHash() uint64 {
+	if x != nil {
+		return x.RecordHash
+	}
+	return 0
+}
+
+type Decision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date   string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Org    string `protobuf:"bytes,3,opt,name=org,proto3" json:"org,omitempty"`
+}
+
+func (x *Decision) Reset() {
+	*x = Decision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decision) ProtoMessage() {}
+
+func (x *Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decision.ProtoReflect.Descriptor instead.
+func (*Decision) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Decision) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Decision) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Decision) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+type TimedString struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Ts    int64  `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (x *TimedString) Reset() {
+	*x = TimedString{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimedString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimedString) ProtoMessage() {}
+
+func (x *TimedString) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimedString.ProtoReflect.Descriptor instead.
+func (*TimedString) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TimedString) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TimedString) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+type TimedIPv4 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip4 uint32 `protobuf:"varint,1,opt,name=ip4,proto3" json:"ip4,omitempty"`
+	Ts  int64  `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (x *TimedIPv4) Reset() {
+	*x = TimedIPv4{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimedIPv4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimedIPv4) ProtoMessage() {}
+
+func (x *TimedIPv4) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimedIPv4.ProtoReflect.Descriptor instead.
+func (*TimedIPv4) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TimedIPv4) GetIp4() uint32 {
+	if x != nil {
+		return x.Ip4
+	}
+	return 0
+}
+
+func (x *TimedIPv4) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+type TimedIPv6 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip6 []byte `protobuf:"bytes,1,opt,name=ip6,proto3" json:"ip6,omitempty"`
+	Ts  int64  `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (x *TimedIPv6) Reset() {
+	*x = TimedIPv6{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimedIPv6) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimedIPv6) ProtoMessage() {}
+
+func (x *TimedIPv6) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimedIPv6.ProtoReflect.Descriptor instead.
+func (*TimedIPv6) Descriptor() ([]byte, []int) {
+	return file_msg_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TimedIPv6) GetIp6() []byte {
+	if x != nil {
+		return x.Ip6
+	}
+	return nil
+}
+
+func (x *TimedIPv6) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+var File_msg_proto protoreflect.FileDescriptor
+
+var file_msg_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0x83, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x7e, 0x0a, 0x0b, 0x49, 0x50, 0x76, 0x34, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x7e, 0x0a, 0x0b, 0x49, 0x50, 0x76, 0x36, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x7d, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x82, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x54,
+	0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61,
+	0x73, 0x4f, 0x66, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50,
+	0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f,
+	0x66, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x0e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0x41, 0x0a, 0x0f, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0b,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x75, 0x6d, 0x70, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x75, 0x6d, 0x70, 0x73, 0x22, 0x21, 0x0a, 0x0b, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x68,
+	0x0a, 0x0c, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6c, 0x61,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66,
+	0x22, 0xc6, 0x02, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x6f, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x68, 0x61,
+	0x73, 0x49, 0x50, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x68, 0x61, 0x73, 0x49, 0x50,
+	0x73, 0x12, 0x27, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x07, 0x68, 0x61, 0x73, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x68,
+	0x61, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x05, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb4, 0x01,
+	0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x22, 0x2c, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x73, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0xc1, 0x05, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x34, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x34, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x70, 0x34, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x70, 0x34, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x36, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x36, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x70, 0x36, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x70, 0x36, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x34, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x36, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x72, 0x6c, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x72, 0x6c, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x72, 0x6c,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75,
+	0x72, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65,
+	0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x72, 0x65, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x0a, 0x4f, 0x72,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x73, 0x4f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22,
+	0x2f, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xcb, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x4a,
+	0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x08,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0b, 0x4c,
+	0x69, 0x6e, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a,
+	0x07, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x05,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x26, 0x0a,
+	0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0xc5, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a,
+	0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a,
+	0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x26, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcc, 0x02, 0x0a, 0x0e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x75, 0x73, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x75, 0x73, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a,
+	0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x11, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd0, 0x01, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9c,
+	0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x89, 0x03,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x34, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x70, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x36,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x70, 0x36, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x67, 0x67, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x67, 0x67, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x63,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x04, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x75, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x24,
+	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04,
+	0x75, 0x72, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x20, 0x0a, 0x03, 0x69, 0x70, 0x34, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x49, 0x50, 0x76, 0x34, 0x52, 0x03, 0x69,
+	0x70, 0x34, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x70, 0x36, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x49, 0x50, 0x76, 0x36, 0x52,
+	0x03, 0x69, 0x70, 0x36, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34,
+	0x12, 0x2a, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x12, 0x1e, 0x0a, 0x0a,
+	0x68, 0x74, 0x74, 0x70, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x22, 0x48, 0x0a, 0x08,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x22, 0x33, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x64, 0x49, 0x50, 0x76, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x34, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x70, 0x34, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x49, 0x50, 0x76, 0x36, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x36, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x70, 0x36, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x2a, 0x46, 0x0a, 0x05, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x02, 0x2a, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41,
+	0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x08,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x53,
+	0x45, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52,
+	0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x10, 0x02, 0x2a, 0x40, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a,
+	0x41, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x02, 0x2a, 0xd9, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f,
+	0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x49, 0x50, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x4d, 0x41, 0x53, 0x4b, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x57, 0x49, 0x4c,
+	0x44, 0x43, 0x41, 0x52, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x55, 0x52, 0x4c, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e,
+	0x44, 0x45, 0x58, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4c, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x45,
+	0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x0e,
+	0x0a, 0x0a, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x84, 0x03, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x50, 0x56, 0x34, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x50, 0x56, 0x34, 0x5f, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x50, 0x56, 0x36,
+	0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x49, 0x50, 0x56, 0x36,
+	0x5f, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x45,
+	0x4e, 0x54, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x4f,
+	0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x08, 0x12, 0x0d, 0x0a,
+	0x09, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0a,
+	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x4f, 0x52, 0x47, 0x10, 0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x51, 0x55,
+	0x45, 0x52, 0x59, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x49,
+	0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x0f, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x10, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4c,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x11, 0x32, 0xca, 0x09, 0x0a,
+	0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
+	0x50, 0x76, 0x34, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x49, 0x50, 0x76, 0x34, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x76, 0x36, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x49,
+	0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x52, 0x4c, 0x12, 0x0f, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x54, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50, 0x76,
+	0x34, 0x12, 0x16, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50,
+	0x76, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x50,
+	0x76, 0x36, 0x12, 0x16, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49,
+	0x50, 0x76, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x72, 0x67, 0x12, 0x0f, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x12, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x11, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x0d, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x69,
+	0x6e, 0x74, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xef, 0x02, 0x0a, 0x05, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x13,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x10, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x13, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x42, 0x20, 0x5a, 0x1e, 0x67,
+	0x75, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x73, 0x68, 0x65, 0x72, 0x32,
+	0x2f, 0x75, 0x32, 0x63, 0x6b, 0x64, 0x75, 0x6d, 0x70, 0x2f, 0x6d, 0x73, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msg_proto_rawDescOnce sync.Once
+	file_msg_proto_rawDescData = file_msg_proto_rawDesc
+)
+
+func file_msg_proto_rawDescGZIP() []byte {
+	file_msg_proto_rawDescOnce.Do(func() {
+		file_msg_proto_rawDescData = protoimpl.X.CompressGZIP(file_msg_proto_rawDescData)
+	})
+	return file_msg_proto_rawDescData
+}
+
+var file_msg_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_msg_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_msg_proto_goTypes = []any{
+	(Order)(0),                    // 0: msg.Order
+	(Resolution)(0),               // 1: msg.Resolution
+	(Presence)(0),                 // 2: msg.Presence
+	(TimeField)(0),                // 3: msg.TimeField
+	(LintStatus)(0),               // 4: msg.LintStatus
+	(ConsistencyCheck)(0),         // 5: msg.ConsistencyCheck
+	(AuditProblem)(0),             // 6: msg.AuditProblem
+	(MatchReason)(0),              // 7: msg.MatchReason
+	(*ContentIDRequest)(nil),      // 8: msg.ContentIDRequest
+	(*IPv4Request)(nil),           // 9: msg.IPv4Request
+	(*IPv6Request)(nil),           // 10: msg.IPv6Request
+	(*URLRequest)(nil),            // 11: msg.URLRequest
+	(*DomainRequest)(nil),         // 12: msg.DomainRequest
+	(*SuffixRequest)(nil),         // 13: msg.SuffixRequest
+	(*DecisionRequest)(nil),       // 14: msg.DecisionRequest
+	(*TextDecisionRequest)(nil),   // 15: msg.TextDecisionRequest
+	(*SubnetIPv4Request)(nil),     // 16: msg.SubnetIPv4Request
+	(*SubnetIPv6Request)(nil),     // 17: msg.SubnetIPv6Request
+	(*EntryTypeRequest)(nil),      // 18: msg.EntryTypeRequest
+	(*SearchResponse)(nil),        // 19: msg.SearchResponse
+	(*Page)(nil),                  // 20: msg.Page
+	(*SummaryRequest)(nil),        // 21: msg.SummaryRequest
+	(*SummaryResponse)(nil),       // 22: msg.SummaryResponse
+	(*SeriesRequest)(nil),         // 23: msg.SeriesRequest
+	(*SeriesResponse)(nil),        // 24: msg.SeriesResponse
+	(*SeriesPoint)(nil),           // 25: msg.SeriesPoint
+	(*PingRequest)(nil),           // 26: msg.PingRequest
+	(*PongResponse)(nil),          // 27: msg.PongResponse
+	(*QueryRequest)(nil),          // 28: msg.QueryRequest
+	(*QueryClause)(nil),           // 29: msg.QueryClause
+	(*TimeRange)(nil),             // 30: msg.TimeRange
+	(*Facet)(nil),                 // 31: msg.Facet
+	(*TimeRequest)(nil),           // 32: msg.TimeRequest
+	(*RecordHistoryRequest)(nil),  // 33: msg.RecordHistoryRequest
+	(*RecordHistoryResponse)(nil), // 34: msg.RecordHistoryResponse
+	(*RecordVersion)(nil),         // 35: msg.RecordVersion
+	(*RecordDiff)(nil),            // 36: msg.RecordDiff
+	(*OrgRequest)(nil),            // 37: msg.OrgRequest
+	(*WithoutNoRequest)(nil),      // 38: msg.WithoutNoRequest
+	(*LintRequest)(nil),           // 39: msg.LintRequest
+	(*LintResponse)(nil),          // 40: msg.LintResponse
+	(*LintRecord)(nil),            // 41: msg.LintRecord
+	(*LintElement)(nil),           // 42: msg.LintElement
+	(*ConsistencyRequest)(nil),    // 43: msg.ConsistencyRequest
+	(*ConsistencyResponse)(nil),   // 44: msg.ConsistencyResponse
+	(*Finding)(nil),               // 45: msg.Finding
+	(*AuditRequest)(nil),          // 46: msg.AuditRequest
+	(*AuditResponse)(nil),         // 47: msg.AuditResponse
+	(*AuditFinding)(nil),          // 48: msg.AuditFinding
+	(*RefreshRequest)(nil),        // 49: msg.RefreshRequest
+	(*ReloadRequest)(nil),         // 50: msg.ReloadRequest
+	(*PauseRequest)(nil),          // 51: msg.PauseRequest
+	(*StatusRequest)(nil),         // 52: msg.StatusRequest
+	(*StatusResponse)(nil),        // 53: msg.StatusResponse
+	(*ProgressRequest)(nil),       // 54: msg.ProgressRequest
+	(*ProgressResponse)(nil),      // 55: msg.ProgressResponse
+	(*UploadChunk)(nil),           // 56: msg.UploadChunk
+	(*UploadResponse)(nil),        // 57: msg.UploadResponse
+	(*Content)(nil),               // 58: msg.Content
+	(*ContentRecord)(nil),         // 59: msg.ContentRecord
+	(*Decision)(nil),              // 60: msg.Decision
+	(*TimedString)(nil),           // 61: msg.TimedString
+	(*TimedIPv4)(nil),             // 62: msg.TimedIPv4
+	(*TimedIPv6)(nil),             // 63: msg.TimedIPv6
+	nil,                           // 64: msg.Facet.CountsEntry
+	nil,                           // 65: msg.ConsistencyResponse.CountsEntry
+	nil,                           // 66: msg.AuditResponse.CountsEntry
+}
+var file_msg_proto_depIdxs = []int32{
+	20, // 0: msg.ContentIDRequest.page:type_name -> msg.Page
+	20, // 1: msg.IPv4Request.page:type_name -> msg.Page
+	20, // 2: msg.IPv6Request.page:type_name -> msg.Page
+	20, // 3: msg.URLRequest.page:type_name -> msg.Page
+	20, // 4: msg.DomainRequest.page:type_name -> msg.Page
+	20, // 5: msg.SuffixRequest.page:type_name -> msg.Page
+	20, // 6: msg.DecisionRequest.page:type_name -> msg.Page
+	20, // 7: msg.TextDecisionRequest.page:type_name -> msg.Page
+	20, // 8: msg.SubnetIPv4Request.page:type_name -> msg.Page
+	20, // 9: msg.SubnetIPv6Request.page:type_name -> msg.Page
+	20, // 10: msg.EntryTypeRequest.page:type_name -> msg.Page
+	58, // 11: msg.SearchResponse.results:type_name -> msg.Content
+	31, // 12: msg.SearchResponse.facets:type_name -> msg.Facet
+	0,  // 13: msg.Page.order:type_name -> msg.Order
+	30, // 14: msg.SeriesRequest.range:type_name -> msg.TimeRange
+	1,  // 15: msg.SeriesRequest.resolution:type_name -> msg.Resolution
+	25, // 16: msg.SeriesResponse.points:type_name -> msg.SeriesPoint
+	29, // 17: msg.QueryRequest.clauses:type_name -> msg.QueryClause
+	20, // 18: msg.QueryRequest.page:type_name -> msg.Page
+	30, // 19: msg.QueryClause.decisionDate:type_name -> msg.TimeRange
+	30, // 20: msg.QueryClause.includeTime:type_name -> msg.TimeRange
+	2,  // 21: msg.QueryClause.hasIPs:type_name -> msg.Presence
+	2,  // 22: msg.QueryClause.hasURLs:type_name -> msg.Presence
+	2,  // 23: msg.QueryClause.hasDomains:type_name -> msg.Presence
+	64, // 24: msg.Facet.counts:type_name -> msg.Facet.CountsEntry
+	30, // 25: msg.TimeRequest.query:type_name -> msg.TimeRange
+	3,  // 26: msg.TimeRequest.field:type_name -> msg.TimeField
+	20, // 27: msg.TimeRequest.page:type_name -> msg.Page
+	35, // 28: msg.RecordHistoryResponse.versions:type_name -> msg.RecordVersion
+	59, // 29: msg.RecordVersion.record:type_name -> msg.ContentRecord
+	36, // 30: msg.RecordVersion.diff:type_name -> msg.RecordDiff
+	60, // 31: msg.RecordDiff.prevDecision:type_name -> msg.Decision
+	20, // 32: msg.OrgRequest.page:type_name -> msg.Page
+	20, // 33: msg.WithoutNoRequest.page:type_name -> msg.Page
+	41, // 34: msg.LintResponse.records:type_name -> msg.LintRecord
+	42, // 35: msg.LintRecord.elements:type_name -> msg.LintElement
+	4,  // 36: msg.LintElement.status:type_name -> msg.LintStatus
+	5,  // 37: msg.ConsistencyRequest.checks:type_name -> msg.ConsistencyCheck
+	65, // 38: msg.ConsistencyResponse.counts:type_name -> msg.ConsistencyResponse.CountsEntry
+	45, // 39: msg.ConsistencyResponse.findings:type_name -> msg.Finding
+	5,  // 40: msg.Finding.check:type_name -> msg.ConsistencyCheck
+	66, // 41: msg.AuditResponse.counts:type_name -> msg.AuditResponse.CountsEntry
+	48, // 42: msg.AuditResponse.findings:type_name -> msg.AuditFinding
+	6,  // 43: msg.AuditFinding.problem:type_name -> msg.AuditProblem
+	7,  // 44: msg.Content.match:type_name -> msg.MatchReason
+	59, // 45: msg.Content.record:type_name -> msg.ContentRecord
+	60, // 46: msg.ContentRecord.decision:type_name -> msg.Decision
+	61, // 47: msg.ContentRecord.urls:type_name -> msg.TimedString
+	61, // 48: msg.ContentRecord.domains:type_name -> msg.TimedString
+	62, // 49: msg.ContentRecord.ip4:type_name -> msg.TimedIPv4
+	63, // 50: msg.ContentRecord.ip6:type_name -> msg.TimedIPv6
+	61, // 51: msg.ContentRecord.subnet4:type_name -> msg.TimedString
+	61, // 52: msg.ContentRecord.subnet6:type_name -> msg.TimedString
+	8,  // 53: msg.Check.SearchContentID:input_type -> msg.ContentIDRequest
+	9,  // 54: msg.Check.SearchIPv4:input_type -> msg.IPv4Request
+	10, // 55: msg.Check.SearchIPv6:input_type -> msg.IPv6Request
+	11, // 56: msg.Check.SearchURL:input_type -> msg.URLRequest
+	12, // 57: msg.Check.SearchDomain:input_type -> msg.DomainRequest
+	14, // 58: msg.Check.SearchDecision:input_type -> msg.DecisionRequest
+	15, // 59: msg.Check.SearchTextDecision:input_type -> msg.TextDecisionRequest
+	16, // 60: msg.Check.SearchSubnetIPv4:input_type -> msg.SubnetIPv4Request
+	17, // 61: msg.Check.SearchSubnetIPv6:input_type -> msg.SubnetIPv6Request
+	13, // 62: msg.Check.SearchDomainSuffix:input_type -> msg.SuffixRequest
+	18, // 63: msg.Check.SearchEntryType:input_type -> msg.EntryTypeRequest
+	21, // 64: msg.Check.Summary:input_type -> msg.SummaryRequest
+	26, // 65: msg.Check.Ping:input_type -> msg.PingRequest
+	37, // 66: msg.Check.SearchOrg:input_type -> msg.OrgRequest
+	38, // 67: msg.Check.SearchWithoutNo:input_type -> msg.WithoutNoRequest
+	28, // 68: msg.Check.Query:input_type -> msg.QueryRequest
+	32, // 69: msg.Check.SearchTime:input_type -> msg.TimeRequest
+	33, // 70: msg.Check.RecordHistory:input_type -> msg.RecordHistoryRequest
+	23, // 71: msg.Check.SummarySeries:input_type -> msg.SeriesRequest
+	39, // 72: msg.Check.Lint:input_type -> msg.LintRequest
+	43, // 73: msg.Check.Consistency:input_type -> msg.ConsistencyRequest
+	46, // 74: msg.Admin.Audit:input_type -> msg.AuditRequest
+	49, // 75: msg.Admin.Refresh:input_type -> msg.RefreshRequest
+	50, // 76: msg.Admin.Reload:input_type -> msg.ReloadRequest
+	51, // 77: msg.Admin.Pause:input_type -> msg.PauseRequest
+	52, // 78: msg.Admin.Status:input_type -> msg.StatusRequest
+	54, // 79: msg.Admin.Progress:input_type -> msg.ProgressRequest
+	56, // 80: msg.Admin.Upload:input_type -> msg.UploadChunk
+	19, // 81: msg.Check.SearchContentID:output_type -> msg.SearchResponse
+	19, // 82: msg.Check.SearchIPv4:output_type -> msg.SearchResponse
+	19, // 83: msg.Check.SearchIPv6:output_type -> msg.SearchResponse
+	19, // 84: msg.Check.SearchURL:output_type -> msg.SearchResponse
+	19, // 85: msg.Check.SearchDomain:output_type -> msg.SearchResponse
+	19, // 86: msg.Check.SearchDecision:output_type -> msg.SearchResponse
+	19, // 87: msg.Check.SearchTextDecision:output_type -> msg.SearchResponse
+	19, // 88: msg.Check.SearchSubnetIPv4:output_type -> msg.SearchResponse
+	19, // 89: msg.Check.SearchSubnetIPv6:output_type -> msg.SearchResponse
+	19, // 90: msg.Check.SearchDomainSuffix:output_type -> msg.SearchResponse
+	19, // 91: msg.Check.SearchEntryType:output_type -> msg.SearchResponse
+	22, // 92: msg.Check.Summary:output_type -> msg.SummaryResponse
+	27, // 93: msg.Check.Ping:output_type -> msg.PongResponse
+	19, // 94: msg.Check.SearchOrg:output_type -> msg.SearchResponse
+	19, // 95: msg.Check.SearchWithoutNo:output_type -> msg.SearchResponse
+	19, // 96: msg.Check.Query:output_type -> msg.SearchResponse
+	19, // 97: msg.Check.SearchTime:output_type -> msg.SearchResponse
+	34, // 98: msg.Check.RecordHistory:output_type -> msg.RecordHistoryResponse
+	24, // 99: msg.Check.SummarySeries:output_type -> msg.SeriesResponse
+	40, // 100: msg.Check.Lint:output_type -> msg.LintResponse
+	44, // 101: msg.Check.Consistency:output_type -> msg.ConsistencyResponse
+	47, // 102: msg.Admin.Audit:output_type -> msg.AuditResponse
+	53, // 103: msg.Admin.Refresh:output_type -> msg.StatusResponse
+	53, // 104: msg.Admin.Reload:output_type -> msg.StatusResponse
+	53, // 105: msg.Admin.Pause:output_type -> msg.StatusResponse
+	53, // 106: msg.Admin.Status:output_type -> msg.StatusResponse
+	55, // 107: msg.Admin.Progress:output_type -> msg.ProgressResponse
+	57, // 108: msg.Admin.Upload:output_type -> msg.UploadResponse
+	81, // [81:109] is the sub-list for method output_type
+	53, // [53:81] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_msg_proto_init() }
+func file_msg_proto_init() {
+	if File_msg_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msg_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ContentIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*IPv4Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*IPv6Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*URLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SuffixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DecisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TextDecisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SubnetIPv4Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SubnetIPv6Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*EntryTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*SummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*SeriesPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PongResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryClause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*TimeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*TimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1262,8 +5718,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPv4Request); i {
+		file_msg_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1274,8 +5730,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPv6Request); i {
+		file_msg_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1286,8 +5742,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*URLRequest); i {
+		file_msg_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1298,8 +5754,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DomainRequest); i {
+		file_msg_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*OrgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1310,8 +5766,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuffixRequest); i {
+		file_msg_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*WithoutNoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1322,8 +5778,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecisionRequest); i {
+		file_msg_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*LintRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1334,8 +5790,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextDecisionRequest); i {
+		file_msg_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*LintResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1346,8 +5802,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubnetIPv4Request); i {
+		file_msg_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*LintRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1358,8 +5814,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubnetIPv6Request); i {
+		file_msg_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*LintElement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1370,8 +5826,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntryTypeRequest); i {
+		file_msg_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsistencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1382,8 +5838,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_msg_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsistencyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1394,8 +5850,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SummaryRequest); i {
+		file_msg_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*Finding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1406,8 +5862,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SummaryResponse); i {
+		file_msg_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1418,8 +5874,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_msg_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,8 +5886,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PongResponse); i {
+		file_msg_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditFinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,8 +5898,8 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgRequest); i {
+		file_msg_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,8 +5910,92 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithoutNoRequest); i {
+		file_msg_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ReloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*PauseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,7 +6006,7 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
-		file_msg_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*Content); i {
 			case 0:
 				return &v.state
@@ -1478,19 +6018,80 @@ func file_msg_proto_init() {
 				return nil
 			}
 		}
+		file_msg_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ContentRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*Decision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*TimedString); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*TimedIPv4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*TimedIPv6); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_msg_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      8,
+			NumMessages:   59,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_msg_proto_goTypes,
 		DependencyIndexes: file_msg_proto_depIdxs,
+		EnumInfos:         file_msg_proto_enumTypes,
 		MessageInfos:      file_msg_proto_msgTypes,
 	}.Build()
 	File_msg_proto = out.File
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
+	"github.com/usher2/u2ckdump/internal/normalize"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Offline query output formats.
+const (
+	offlineOutputText = "text"
+	offlineOutputJSON = "json"
+)
+
+// Errors
+var (
+	ErrUnknownQueryKind = errors.New("unknown query kind")
+	ErrBadQuery         = lookup.ErrBadQuery
+)
+
+const offlineHelp = `Query kinds:
+  id <content ID>
+  ip <IPv4 or IPv6>
+  url <URL>
+  domain <domain>
+  suffix <domain> [variant]
+  decision <hash or org|number|date>
+  org <decision org>
+  entry-type <entry type key>
+  without-no
+  summary
+  history <content ID>
+`
+
+// offlineQuery - Check service queries against the current dump without the gRPC server.
+type offlineQuery struct {
+	srv            *server
+	includeRemoved bool
+	json           bool
+	w              *bufio.Writer
+}
+
+// run - answers the query line: the kind and the arguments.
+func (q *offlineQuery) run(line string) error {
+	kind, arg, _ := strings.Cut(strings.TrimSpace(line), " ")
+	arg = strings.TrimSpace(arg)
+
+	ctx := context.Background()
+
+	switch kind {
+	case "summary":
+		resp, _ := q.srv.Summary(ctx, &pb.SummaryRequest{})
+		if resp.GetError() != "" {
+			return fmt.Errorf("%s", resp.GetError())
+		}
+
+		fmt.Fprintf(q.w, "%s\n", resp.GetSummary())
+
+		return nil
+	case "history":
+		id, err := strconv.ParseInt(arg, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrBadQuery, err)
+		}
+
+		resp, _ := q.srv.RecordHistory(ctx, &pb.RecordHistoryRequest{Query: int32(id)})
+		if resp.GetError() != "" {
+			return fmt.Errorf("%s", resp.GetError())
+		}
+
+		for _, v := range resp.GetVersions() {
+			if q.json {
+				q.writeJSON(v)
+
+				continue
+			}
+
+			fmt.Fprintf(q.w, "#%d since %d until %d %s %s %s\n", id, v.GetSince(), v.GetUntil(),
+				v.GetRecord().GetDecision().GetOrg(), v.GetRecord().GetDecision().GetNumber(), v.GetRecord().GetDecision().GetDate())
+		}
+
+		return nil
+	}
+
+	search, err := q.search(kind, arg)
+	if err != nil {
+		return err
+	}
+
+	// all pages.
+	page := &pb.Page{PageSize: maxPageSize}
+
+	for {
+		resp := search(page)
+		if resp.GetError() != "" {
+			return fmt.Errorf("%s", resp.GetError())
+		}
+
+		for _, content := range resp.GetResults() {
+			q.writeContent(content)
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+
+		page.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// search - the server search of the kind with normalized arguments.
+func (q *offlineQuery) search(kind, arg string) (func(page *pb.Page) *pb.SearchResponse, error) {
+	ctx := context.Background()
+	removed := q.includeRemoved
+
+	switch kind {
+	case "id":
+		id, err := strconv.ParseInt(arg, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBadQuery, err)
+		}
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchContentID(ctx, &pb.ContentIDRequest{Query: int32(id), Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "ip":
+		ip := net.ParseIP(arg)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: %s", ErrBadQuery, arg)
+		}
+
+		if ip4 := ip.To4(); ip4 != nil {
+			return func(page *pb.Page) *pb.SearchResponse {
+				resp, _ := q.srv.SearchIPv4(ctx, &pb.IPv4Request{Query: IPv4StrToInt(ip4.String()), Page: page, IncludeRemoved: removed})
+
+				return resp
+			}, nil
+		}
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchIPv6(ctx, &pb.IPv6Request{Query: ip.To16(), Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "url":
+		u := normalize.URL(arg)
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchURL(ctx, &pb.URLRequest{Query: u, Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "domain":
+		domain := normalize.Domain(arg)
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchDomain(ctx, &pb.DomainRequest{Query: domain, Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "suffix":
+		domain, variantStr, _ := strings.Cut(arg, " ")
+
+		var variant int64
+
+		if variantStr != "" {
+			var err error
+			if variant, err = strconv.ParseInt(strings.TrimSpace(variantStr), 10, 32); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrBadQuery, err)
+			}
+		}
+
+		domain = normalize.Domain(domain)
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchDomainSuffix(ctx, &pb.SuffixRequest{Query: domain, Variant: int32(variant), Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "decision":
+		hash, err := lookup.Decision(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchDecision(ctx, &pb.DecisionRequest{Query: hash, Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "org":
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchOrg(ctx, &pb.OrgRequest{Query: lookup.Hash(arg), Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "entry-type":
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchEntryType(ctx, &pb.EntryTypeRequest{Query: arg, Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	case "without-no":
+		return func(page *pb.Page) *pb.SearchResponse {
+			resp, _ := q.srv.SearchWithoutNo(ctx, &pb.WithoutNoRequest{Page: page, IncludeRemoved: removed})
+
+			return resp
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownQueryKind, kind)
+	}
+}
+
+func (q *offlineQuery) writeContent(content *pb.Content) {
+	if q.json {
+		q.writeJSON(content)
+
+		return
+	}
+
+	rec := content.GetRecord()
+
+	fmt.Fprintf(q.w, "#%d %s %s %s %s %s %s %s", content.GetId(), blockTypeName(content.GetBlockType()), rec.GetEntryTypeKey(),
+		rec.GetDecision().GetOrg(), rec.GetDecision().GetNumber(), rec.GetDecision().GetDate(), content.GetMatch(), content.GetMatchKey())
+
+	if content.GetRemoved() {
+		fmt.Fprintf(q.w, " removed %d", content.GetRemovedTime())
+	}
+
+	fmt.Fprintln(q.w)
+}
+
+func (q *offlineQuery) writeJSON(m proto.Message) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		logger.Error.Printf("Can't encode: %s\n", err.Error())
+
+		return
+	}
+
+	fmt.Fprintf(q.w, "%s\n", b)
+}
+
+// offlineMain - query command: Check service queries against the parsed dump or the saved snapshot without the server.
+// Without arguments queries are read from stdin, it is the interactive prompt on the terminal.
+func offlineMain(args []string) int {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	confDumpFile := fs.String("i", "res/dump.xml", "Dump file, zipped or plain")
+	confHistoryFile := fs.String("history", "", "History or snapshot file, the snapshot is used instead of the dump file")
+	confAt := fs.String("at", "", "Snapshot time, RFC 3339, empty is the latest")
+	confSave := fs.String("save", "", "Save the parsed dump as the snapshot file for -history")
+	confOutput := fs.String("o", offlineOutputText, "Output format: text or json")
+	confRemoved := fs.Bool("removed", false, "Include removed records")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: u2ckdump query [flags] [<kind> <query>]\n\n%s\nFlags:\n", offlineHelp)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	if *confOutput != offlineOutputText && *confOutput != offlineOutputJSON {
+		logger.Error.Printf("Unknown output format: %s\n", *confOutput)
+
+		return 1
+	}
+
+	dump, err := loadDump(*confDumpFile, *confHistoryFile, *confAt, *confSave)
+	if err != nil {
+		logger.Error.Printf("Can't load dump: %s\n", err.Error())
+
+		return 1
+	}
+
+	CurrentDump = dump
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	q := &offlineQuery{srv: &server{}, includeRemoved: *confRemoved, json: *confOutput == offlineOutputJSON, w: w}
+
+	if fs.NArg() > 0 {
+		if err := q.run(strings.Join(fs.Args(), " ")); err != nil {
+			w.Flush()
+			logger.Error.Printf("Query error: %s\n", err.Error())
+
+			return 1
+		}
+
+		return 0
+	}
+
+	return q.prompt(os.Stdin, isTerminal(os.Stdin))
+}
+
+// prompt - answers query lines till EOF or quit.
+func (q *offlineQuery) prompt(r io.Reader, interactive bool) int {
+	w := q.w
+	scanner := bufio.NewScanner(r)
+
+	var failed bool
+
+	for {
+		if interactive {
+			fmt.Fprint(w, "> ")
+			w.Flush()
+		}
+
+		if !scanner.Scan() {
+			break
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "quit" || line == "exit" {
+			break
+		}
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case line == "help":
+			fmt.Fprint(w, offlineHelp)
+
+			continue
+		}
+
+		if err := q.run(line); err != nil {
+			fmt.Fprintf(w, "ERROR: %s\n", err.Error())
+
+			failed = true
+		}
+
+		w.Flush()
+	}
+
+	if interactive {
+		fmt.Fprintln(w)
+	}
+
+	if failed && !interactive {
+		return 1
+	}
+
+	return 0
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
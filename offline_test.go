@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOfflineQuery(t *testing.T) {
+	dir := t.TempDir()
+	dumpFile := filepath.Join(dir, "dump.xml")
+	snapshotFile := filepath.Join(dir, "snapshot.db")
+
+	if err := os.WriteFile(dumpFile, []byte(xml01), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loadDump(dumpFile, "", "", snapshotFile); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(snapshotFile); err != nil {
+		t.Fatalf("Expected snapshot, got %v", err)
+	}
+
+	// the saved snapshot answers as the parsed dump.
+	dump, err := loadDump("", snapshotFile, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	CurrentDump = dump
+
+	input := strings.Join([]string{
+		"# comment",
+		"ip 10.4.1.1",
+		"domain WWW.e02.tld",
+		"url http://www.e01.tld/cheese#top",
+		"decision ONE|1/1/11-1111|2000-01-01",
+		"org THREE",
+		"id 999",
+		"nonsense 1",
+		"quit",
+		"id 111",
+	}, "\n")
+
+	var out strings.Builder
+
+	w := bufio.NewWriter(&out)
+	q := &offlineQuery{srv: &server{}, w: w}
+
+	if code := q.prompt(strings.NewReader(input), false); code != 1 {
+		t.Errorf("Expected 1, got %d", code)
+	}
+
+	w.Flush()
+
+	want := strings.Join([]string{
+		"#444 ip 15_1 FOUR 4/4/44-4444 2001-01-04 MATCH_IPV4_SUBNET 10.4.0.0/16",
+		"#222 domain 15_1 TWO 2/2/22-2222 2000-01-02 MATCH_DOMAIN www.e02.tld",
+		"#555 domain 15_1 FIVE 5/5/55-5555 2001-01-05 MATCH_DOMAIN www.e02.tld",
+		"#111 https 15_1 ONE 1/1/11-1111 2000-01-01 MATCH_URL http://www.e01.tld/cheese",
+		fmt.Sprintf("#111 https 15_1 ONE 1/1/11-1111 2000-01-01 MATCH_DECISION %d", hashDecision(&Decision{Org: "ONE", Number: "1/1/11-1111", Date: "2000-01-01"})),
+		"#333 ip 15_1 THREE 3/3/33-3333 2001-01-03 MATCH_ORG THREE",
+		"ERROR: " + ErrUnknownQueryKind.Error() + ": nonsense",
+	}, "\n") + "\n"
+
+	if out.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, out.String())
+	}
+}
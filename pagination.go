@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Page sizes.
+const (
+	defaultPageSize = 1000
+	maxPageSize     = 10000
+)
+
+// Errors
+var (
+	ErrBadPageToken     = errors.New("bad page token")
+	ErrPageTokenExpired = errors.New("page token expired")
+)
+
+// pageCursor - position in the ordered result set of a snapshot.
+type pageCursor struct {
+	Generation uint64
+	Order      pb.Order
+	Query      uint64
+	Offset     int
+}
+
+func (c pageCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d:%d:%d:%d", c.Generation, c.Order, c.Query, c.Offset))
+}
+
+func decodePageCursor(token string) (pageCursor, error) {
+	var c pageCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("%w: %w", ErrBadPageToken, err)
+	}
+
+	if _, err := fmt.Sscanf(string(b), "%d:%d:%d:%d", &c.Generation, &c.Order, &c.Query, &c.Offset); err != nil || c.Offset < 0 {
+		return c, ErrBadPageToken
+	}
+
+	return c, nil
+}
+
+// sortMatches - sorts matches in the requested order, ORDER_MATCH keeps the search order.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) sortMatches(matches []Match, order pb.Order) {
+	switch order {
+	case pb.Order_ORDER_CONTENT_ID:
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].ID < matches[j].ID
+		})
+	case pb.Order_ORDER_INCLUDE_TIME:
+		sort.SliceStable(matches, func(i, j int) bool {
+			ti, tj := dump.includeTime(matches[i]), dump.includeTime(matches[j])
+			if ti != tj {
+				return ti < tj
+			}
+
+			return matches[i].ID < matches[j].ID
+		})
+	}
+}
+
+func (dump *Dump) includeTime(m Match) int64 {
+	if cont, ok := dump.content(m); ok {
+		return cont.IncludeTime
+	}
+
+	return 0
+}
+
+// pageQuery - the query of the page token, tombstones change the offsets.
+func pageQuery(query string, includeRemoved bool) uint64 {
+	if includeRemoved {
+		query += "\x00removed"
+	}
+
+	return String2fnv2uint64(query)
+}
+
+// fillResponse - orders and paginates matches, fills results, total count and the next page token.
+// The page token is tied to the query and to the dump generation it was issued from,
+// it is not accepted while the dump is parsed. Without the page all matches are returned.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) fillResponse(resp *pb.SearchResponse, matches []Match, page *pb.Page, includeRemoved bool) {
+	size := int(page.GetPageSize())
+
+	switch {
+	case page == nil:
+		size = len(matches)
+	case size <= 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	cursor := pageCursor{
+		Generation: dump.generation,
+		Order:      page.GetOrder(),
+		Query:      pageQuery(resp.Query, includeRemoved),
+	}
+
+	if token := page.GetPageToken(); token != "" {
+		prev, err := decodePageCursor(token)
+		if err != nil {
+			resp.Error = err.Error()
+
+			return
+		}
+
+		if prev.Order != cursor.Order || prev.Query != cursor.Query {
+			resp.Error = ErrBadPageToken.Error()
+
+			return
+		}
+
+		if prev.Generation != cursor.Generation || dump.parsing {
+			resp.Error = ErrPageTokenExpired.Error()
+
+			return
+		}
+
+		cursor.Offset = prev.Offset
+	}
+
+	dump.sortMatches(matches, cursor.Order)
+
+	resp.TotalCount = int32(len(matches))
+
+	if cursor.Offset >= len(matches) {
+		resp.Results = make([]*pb.Content, 0)
+
+		return
+	}
+
+	end := min(cursor.Offset+size, len(matches))
+	resp.Results = dump.contents(matches[cursor.Offset:end])
+
+	if end < len(matches) {
+		cursor.Offset = end
+		resp.NextPageToken = cursor.encode()
+	}
+}
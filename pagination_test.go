@@ -0,0 +1,130 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestFillResponsePages(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		ids   []int32
+		token string
+		pages int
+	)
+
+	for {
+		resp := &pb.SearchResponse{Query: "15_1"}
+		page := &pb.Page{PageSize: 2, PageToken: token, Order: pb.Order_ORDER_INCLUDE_TIME}
+
+		CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), page, false)
+
+		if resp.Error != "" {
+			t.Fatalf("Unexpected error: %s", resp.Error)
+		}
+
+		if resp.TotalCount != 5 {
+			t.Errorf("Expected total 5, got %d", resp.TotalCount)
+		}
+
+		for _, cont := range resp.Results {
+			ids = append(ids, cont.Id)
+		}
+
+		pages++
+		token = resp.NextPageToken
+
+		if token == "" {
+			break
+		}
+	}
+
+	want := []int32{111, 222, 333, 444, 555}
+	if pages != 3 || len(ids) != len(want) {
+		t.Fatalf("Expected %v in 3 pages, got %v in %d", want, ids, pages)
+	}
+
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, ids)
+		}
+	}
+}
+
+func TestFillResponseTokens(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), &pb.Page{PageSize: 1}, false)
+
+	token := resp.NextPageToken
+
+	resp = &pb.SearchResponse{Query: "15_2"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_2"), &pb.Page{PageSize: 1, PageToken: token}, false)
+
+	if resp.Error != ErrBadPageToken.Error() {
+		t.Errorf("Expected bad token for another query, got %q", resp.Error)
+	}
+
+	resp = &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), &pb.Page{PageSize: 1, PageToken: "garbage"}, false)
+
+	if resp.Error == "" {
+		t.Errorf("Expected error for garbage token")
+	}
+
+	resp = &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), &pb.Page{PageSize: 1, PageToken: token}, true)
+
+	if resp.Error != ErrBadPageToken.Error() {
+		t.Errorf("Expected bad token with removed records, got %q", resp.Error)
+	}
+
+	CurrentDump.beginParse()
+
+	resp = &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), &pb.Page{PageSize: 1, PageToken: token}, false)
+
+	if resp.Error != ErrPageTokenExpired.Error() {
+		t.Errorf("Expected expired token while parsing, got %q", resp.Error)
+	}
+
+	CurrentDump.endParse()
+
+	if err := Parse(strings.NewReader(xml02)); err != nil {
+		t.Fatal(err)
+	}
+
+	resp = &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), &pb.Page{PageSize: 1, PageToken: token}, false)
+
+	if resp.Error != ErrPageTokenExpired.Error() {
+		t.Errorf("Expected expired token after new dump, got %q", resp.Error)
+	}
+}
+
+func TestFillResponseWithoutPage(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &pb.SearchResponse{Query: "15_1"}
+	CurrentDump.fillResponse(resp, CurrentDump.searchEntryType("15_1"), nil, false)
+
+	if len(resp.Results) != 5 || resp.NextPageToken != "" {
+		t.Errorf("Expected all 5 results without token, got %d %q", len(resp.Results), resp.NextPageToken)
+	}
+}
@@ -56,3 +56,22 @@ func parseMoscowTime(s string) int64 {
 
 	return t.Unix()
 }
+
+// parseDecisionDate is a format for parsing decision dates.
+const parseDecisionDate = "2006-01-02"
+
+// parseDecisionTime converts a decision date in the Moscow timezone to a Unix timestamp.
+// Returns 0 if the input string is empty or the parsing fails.
+func parseDecisionTime(s string) int64 {
+	if s == "" {
+		return 0
+	}
+
+	t, err := time.ParseInLocation(parseDecisionDate, s, locationMSK)
+	if err != nil {
+		logger.Debug.Printf("Can't parse decision date: %s (%s)\n", err, s)
+		return 0
+	}
+
+	return t.Unix()
+}
@@ -2,19 +2,21 @@ package main
 
 import (
 	"bytes"
-	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"hash"
 	"hash/fnv"
 	"io"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 
 	"golang.org/x/net/html/charset"
 
 	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
+	"github.com/usher2/u2ckdump/internal/normalize"
 	pb "github.com/usher2/u2ckdump/msg"
 )
 
@@ -32,6 +34,7 @@ const (
 var hasher64 hash.Hash64
 
 // UnmarshalContent - unmarshal <content> element.
+// Elements are linted, invalid ones are quarantined: collected in Lint only.
 func UnmarshalContent(contBuf []byte, content *Content) error {
 	buf := bytes.NewReader(contBuf)
 	decoder := xml.NewDecoder(buf)
@@ -64,42 +67,54 @@ func UnmarshalContent(contBuf []byte, content *Content) error {
 					return fmt.Errorf("parse url elm: %w", err)
 				}
 
-				content.URL = append(content.URL, URL{URL: u.URL, Ts: parseRFC3339Time(u.Ts)})
+				if content.keep(lintURL(u.URL)) {
+					content.URL = append(content.URL, URL{URL: u.URL, Ts: parseRFC3339Time(u.Ts)})
+				}
 			case elementDomain:
 				domain := XMLDomain{}
 				if err := decoder.DecodeElement(&domain, &element); err != nil {
 					return fmt.Errorf("parse domain elm: %w", err)
 				}
 
-				content.Domain = append(content.Domain, Domain{Domain: domain.Domain, Ts: parseRFC3339Time(domain.Ts)})
+				if content.keep(lintDomain(domain.Domain)) {
+					content.Domain = append(content.Domain, Domain{Domain: domain.Domain, Ts: parseRFC3339Time(domain.Ts)})
+				}
 			case elementIP4:
 				ip4 := XMLIP{}
 				if err := decoder.DecodeElement(&ip4, &element); err != nil {
 					return fmt.Errorf("parse ip elm: %w", err)
 				}
 
-				content.IPv4 = append(content.IPv4, IPv4{IPv4: IPv4StrToInt(ip4.IP), Ts: parseRFC3339Time(ip4.Ts)})
+				if ip, issue := lintIPv4(ip4.IP); content.keep(issue) {
+					content.IPv4 = append(content.IPv4, IPv4{IPv4: ip, Ts: parseRFC3339Time(ip4.Ts)})
+				}
 			case elementIP6:
 				ip6 := XMLIP6{}
 				if err := decoder.DecodeElement(&ip6, &element); err != nil {
 					return fmt.Errorf("parse ipv6 elm: %w", err)
 				}
 
-				content.IPv6 = append(content.IPv6, IPv6{IPv6: net.ParseIP(ip6.IP6), Ts: parseRFC3339Time(ip6.Ts)})
+				if ip, issue := lintIPv6(ip6.IP6); content.keep(issue) {
+					content.IPv6 = append(content.IPv6, IPv6{IPv6: ip, Ts: parseRFC3339Time(ip6.Ts)})
+				}
 			case elementIP4Subnet:
 				subnet4 := XMLSubnet{}
 				if err := decoder.DecodeElement(&subnet4, &element); err != nil {
 					return fmt.Errorf("parse subnet elm: %w", err)
 				}
 
-				content.SubnetIPv4 = append(content.SubnetIPv4, SubnetIPv4{SubnetIPv4: subnet4.Subnet, Ts: parseRFC3339Time(subnet4.Ts)})
+				if subnet, issue := lintSubnet(elementIP4Subnet, subnet4.Subnet); content.keep(issue) {
+					content.SubnetIPv4 = append(content.SubnetIPv4, SubnetIPv4{SubnetIPv4: subnet, Ts: parseRFC3339Time(subnet4.Ts)})
+				}
 			case elementIP6Subnet:
 				subnet6 := XMLSubnet6{}
 				if err := decoder.DecodeElement(&subnet6, &element); err != nil {
 					return fmt.Errorf("parse ipv6 subnet elm: %w", err)
 				}
 
-				content.SubnetIPv6 = append(content.SubnetIPv6, SubnetIPv6{SubnetIPv6: subnet6.Subnet6, Ts: parseRFC3339Time(subnet6.Ts)})
+				if subnet, issue := lintSubnet(elementIP6Subnet, subnet6.Subnet6); content.keep(issue) {
+					content.SubnetIPv6 = append(content.SubnetIPv6, SubnetIPv6{SubnetIPv6: subnet, Ts: parseRFC3339Time(subnet6.Ts)})
+				}
 			}
 		}
 	}
@@ -156,8 +171,14 @@ func Parse(dumpFile io.Reader) error {
 		stats ParseStatistics
 	)
 
+	ParseProgress.begin(readerSize(dumpFile))
+	defer ParseProgress.end()
+
+	CurrentDump.beginParse()
+	defer CurrentDump.endParse()
+
 	hasher64 = fnv.New64a()
-	decoder := xml.NewDecoder(dumpFile)
+	decoder := xml.NewDecoder(&progressReader{r: dumpFile, progress: ParseProgress})
 
 	// we need this closure, we don't want constructor
 	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
@@ -252,6 +273,7 @@ func Parse(dumpFile io.Reader) error {
 
 				CurrentDump.Unlock()
 				stats.Count++
+				ParseProgress.records.Add(1)
 			}
 		}
 
@@ -263,9 +285,12 @@ func Parse(dumpFile io.Reader) error {
 
 	// Cleanup.
 	statistics := CurrentDump.Cleanup(ContJournal, &stats, reg.UpdateTime)
+	CurrentDump.history.flush() // the file is written without the dump lock.
+	CurrentDump.refreshConsistency()
 
 	stats.Update()
 	Summary.Store(statistics)
+	StatSeries.Add(statistics)
 
 	logger.Debug.Printf("Statistics: %#v\n", statistics)
 
@@ -294,13 +319,32 @@ func NewContent(recordHash uint64, buf []byte) (*Content, error) {
 	return content, nil
 }
 
+// beginParse - records are applied one by one, the issued page tokens are invalidated.
+func (dump *Dump) beginParse() {
+	dump.Lock()
+	defer dump.Unlock()
+
+	dump.parsing = true
+	dump.generation++
+}
+
+func (dump *Dump) endParse() {
+	dump.Lock()
+	defer dump.Unlock()
+
+	dump.parsing = false
+}
+
 func (dump *Dump) Cleanup(existed Int32Map, stats *ParseStatistics, utime int64) *SummaryValues {
 	dump.Lock()
 	defer dump.Unlock()
 
-	dump.purge(existed, stats)   // remove deleted records from index.
-	dump.calcMaxEntityLen(stats) // calc max entity len.
-	dump.utime = utime           // set global update time.
+	dump.purge(existed, stats, utime) // remove deleted records from index.
+	dump.calcMaxEntityLen(stats)      // calc max entity len.
+	dump.utime = utime                // set global update time.
+	dump.generation++                 // invalidate page tokens.
+	dump.buildTimeIndexes()           // rebuild time indexes.
+	dump.tombstones.refresh(existed, utime)
 
 	statisctics := &SummaryValues{
 		UpdateTime:        dump.utime,
@@ -337,20 +381,23 @@ func (dump *Dump) Cleanup(existed Int32Map, stats *ParseStatistics, utime int64)
 		statisctics.EntryTypes[entryTypeKey] = len(list)
 	}
 
-	for org := range dump.packedOrgIndex {
-		delete(dump.packedOrgIndex, org)
-	}
-
 	for org, list := range dump.orgIndex {
 		statisctics.DecisionOrgs[org] = len(list)
-		dump.packedOrgIndex[String2fnv2uint64(org)] = org
 	}
 
+	dump.buildPackedOrgIndex()
+	dump.history.commit(dump.ContentIndex, utime)
+
 	statisctics.LargestSizeOfContent = stats.LargestSizeOfContent
 	statisctics.LargestSizeOfContentCintentID = stats.LargestSizeOfContentCintentID
 	statisctics.MaxItemReferences = stats.MaxItemReferences
 	statisctics.MaxItemReferencesString = stats.MaxItemReferencesString
 	statisctics.EntriesWithoutDecisionNo = len(dump.withoutDecisionNo)
+	statisctics.Count = stats.Count
+	statisctics.Added = stats.AddCount
+	statisctics.Updated = stats.UpdateCount
+	statisctics.Removed = stats.RemoveCount
+	statisctics.RepairedElements, statisctics.QuarantinedElements = dump.lint.count()
 
 	return statisctics
 }
@@ -449,56 +496,25 @@ func (dump *Dump) calcMaxEntityLen(stats *ParseStatistics) {
 	}
 }
 
-// purge - remove deleted records from index.
-func (dump *Dump) purge(existed Int32Map, stats *ParseStatistics) {
+// purge - remove deleted records from index and bury them as tombstones.
+func (dump *Dump) purge(existed Int32Map, stats *ParseStatistics, utime int64) {
 	for id, cont := range dump.ContentIndex {
 		if _, ok := existed[id]; !ok {
-			for _, ip4 := range cont.IPv4 {
-				dump.RemoveFromIPv4Index(ip4.IPv4, cont.ID)
-			}
-
-			for _, ip6 := range cont.IPv6 {
-				ip6 := string(ip6.IPv6)
-				dump.RemoveFromIPv6Index(ip6, cont.ID)
-			}
+			dump.removeFromIndexes(cont)
 
-			for _, subnet6 := range cont.SubnetIPv6 {
-				dump.RemoveFromSubnetIPv6Index(subnet6.SubnetIPv6, cont.ID)
-			}
-
-			for _, subnet4 := range cont.SubnetIPv4 {
-				dump.RemoveFromSubnetIPv4Index(subnet4.SubnetIPv4, cont.ID)
-			}
-
-			for _, u := range cont.URL {
-				dump.RemoveFromURLIndex(NormalizeURL(u.URL), cont.ID)
-			}
+			delete(dump.ContentIndex, id)
+			delete(dump.lint, id)
+			delete(dump.findings, id)
 
-			for _, domain := range cont.Domain {
-				dump.RemoveFromDomainIndex(NormalizeDomain(domain.Domain), cont.ID)
+			if dump.tombstones != nil {
+				dump.tombstones.bury(cont, utime)
 			}
 
-			dump.RemoveFromDecisionIndex(cont.Decision, cont.ID)
-			dump.RemoveFromDecisionOrgIndex(cont.DecisionOrg, cont.ID)
-			dump.RemoveFromDecisionWithoutNoIndex(cont.ID)
-			dump.RemoveFromEntryTypeIndex(entryTypeKey(cont.EntryType, cont.DecisionOrg, cont.DecisionNumber), cont.ID)
-
-			delete(dump.ContentIndex, id)
-
 			stats.RemoveCount++
 		}
 	}
 }
 
-// Marshal - encodes content to JSON.
-func (record *Content) Marshal() []byte {
-	b, err := json.Marshal(record)
-	if err != nil {
-		logger.Error.Printf("Error encoding: %s\n", err.Error())
-	}
-	return b
-}
-
 // constructBlockType - returns block type for content.
 func (record *Content) constructBlockType() int32 {
 	switch record.BlockType {
@@ -527,22 +543,28 @@ func (dump *Dump) SetContentUpdateTime(id int32, updateTime int64) {
 // MergePackedContent - merges new content with previous one.
 // It is used to update existing content.
 func (dump *Dump) MergePackedContent(record *Content, prev *PackedContent, updateTime int64) {
-	prev.refreshPackedContent(record.RecordHash, updateTime, record.Marshal())
-
 	dump.EctractAndApplyUpdateIPv4(record, prev)
 	dump.EctractAndApplyUpdateIPv6(record, prev)
 	dump.EctractAndApplyUpdateSubnetIPv4(record, prev)
 	dump.EctractAndApplyUpdateSubnetIPv6(record, prev)
 	dump.EctractAndApplyUpdateDomain(record, prev)
 	dump.EctractAndApplyUpdateURL(record, prev)
-	dump.EctractAndApplyUpdateDecision(record, prev)  // reason for ALARM!!!
-	dump.EctractAndApplyUpdateEntryType(record, prev) // reason for ALARM!!!
+	dump.EctractAndApplyUpdateDecision(record, prev)
+	dump.EctractAndApplyUpdateEntryType(record, prev)
+	dump.lint.set(record.ID, record.Lint)
+	dump.findings.set(record.ID, recordFindings(record, prev))
+
+	prev.IncludeTime, prev.Ts = record.IncludeTime, record.Ts
+
+	// marshal after extraction, HTTPSBlock is counted there.
+	prev.refreshPackedContent(record.RecordHash, updateTime, record.Marshal())
 }
 
 // NewPackedContent - creates new content.
 // It is used to add new content.
 func (dump *Dump) NewPackedContent(record *Content, updateTime int64) {
-	fresh := newPackedContent(record.ID, record.RecordHash, updateTime, record.Marshal())
+	fresh := newPackedContent(record.ID, record.RecordHash, updateTime, nil)
+	fresh.IncludeTime, fresh.Ts = record.IncludeTime, record.Ts
 	dump.ContentIndex[record.ID] = fresh
 
 	dump.ExtractAndApplyIPv4(record, fresh)
@@ -553,6 +575,11 @@ func (dump *Dump) NewPackedContent(record *Content, updateTime int64) {
 	dump.ExtractAndApplyURL(record, fresh)
 	dump.ExtractAndApplyDecision(record, fresh)
 	dump.ExtractAndApplyEntryType(record, fresh)
+	dump.lint.set(record.ID, record.Lint)
+	dump.findings.set(record.ID, recordFindings(record, nil))
+
+	// marshal after extraction, HTTPSBlock is counted there.
+	fresh.Payload = record.Marshal()
 }
 
 func (dump *Dump) ExtractAndApplyEntryType(record *Content, pack *PackedContent) {
@@ -562,8 +589,12 @@ func (dump *Dump) ExtractAndApplyEntryType(record *Content, pack *PackedContent)
 	dump.InsertToEntryTypeIndex(pack.EntryTypeString, pack.ID)
 }
 
-// IT IS REASON FOR ALARM!!!!
+// EctractAndApplyUpdateEntryType - the entry type of the existing record must not change, it is logged as an alarm.
 func (dump *Dump) EctractAndApplyUpdateEntryType(record *Content, pack *PackedContent) {
+	if pack.EntryType != record.EntryType {
+		logger.Warning.Printf("ALARM! #%d entry type changed: %d -> %d\n", pack.ID, pack.EntryType, record.EntryType)
+	}
+
 	dump.RemoveFromEntryTypeIndex(pack.EntryTypeString, pack.ID)
 
 	pack.EntryType = record.EntryType
@@ -587,6 +618,7 @@ func makeRightDecisionOrg(org string) string {
 
 func (dump *Dump) ExtractAndApplyDecision(record *Content, pack *PackedContent) {
 	pack.Decision = hashDecision(&record.Decision)
+	pack.DecisionDate = parseDecisionTime(record.Decision.Date)
 	pack.DecisionOrg = makeRightDecisionOrg(record.Decision.Org)
 	pack.DecisionNumber = record.Decision.Number
 
@@ -595,13 +627,19 @@ func (dump *Dump) ExtractAndApplyDecision(record *Content, pack *PackedContent)
 	dump.InsertToDecisionWithoutNoIndex(record.Decision.Number, pack.ID)
 }
 
-// IT IS REASON FOR ALARM!!!!
+// EctractAndApplyUpdateDecision - the decision of the existing record must not change, it is logged as an alarm.
 func (dump *Dump) EctractAndApplyUpdateDecision(record *Content, pack *PackedContent) {
+	if decision := hashDecision(&record.Decision); pack.Decision != decision {
+		logger.Warning.Printf("ALARM! #%d decision changed: %s %s -> %s %s %s\n", pack.ID,
+			pack.DecisionOrg, pack.DecisionNumber, record.Decision.Org, record.Decision.Number, record.Decision.Date)
+	}
+
 	dump.RemoveFromDecisionIndex(pack.Decision, pack.ID)
 	dump.RemoveFromDecisionOrgIndex(pack.DecisionOrg, pack.ID)
 	dump.RemoveFromDecisionWithoutNoIndex(pack.ID)
 
 	pack.Decision = hashDecision(&record.Decision)
+	pack.DecisionDate = parseDecisionTime(record.Decision.Date)
 	pack.DecisionOrg = makeRightDecisionOrg(record.Decision.Org)
 	pack.DecisionNumber = record.Decision.Number
 
@@ -612,13 +650,7 @@ func (dump *Dump) EctractAndApplyUpdateDecision(record *Content, pack *PackedCon
 
 func hashDecision(decision *Decision) uint64 {
 	// hash.Write([]byte(v0.Decision.Org + " " + v0.Decision.Number + " " + v0.Decision.Date))
-	hasher64.Reset()
-	hasher64.Write([]byte(decision.Org))
-	hasher64.Write([]byte(" "))
-	hasher64.Write([]byte(decision.Number))
-	hasher64.Write([]byte(" "))
-	hasher64.Write([]byte(decision.Date))
-	return hasher64.Sum64()
+	return lookup.DecisionHash(decision.Org, decision.Number, decision.Date)
 }
 
 func (dump *Dump) ExtractAndApplyIPv4(record *Content, pack *PackedContent) {
@@ -640,7 +672,7 @@ func (dump *Dump) EctractAndApplyUpdateIPv4(record *Content, pack *PackedContent
 		}
 	}
 
-	for _, ip4 := range pack.IPv4 {
+	for _, ip4 := range slices.Clone(pack.IPv4) {
 		if _, ok := ipExisted[ip4.IPv4]; !ok {
 			pack.RemoveIPv4(ip4)
 			dump.RemoveFromIPv4Index(ip4.IPv4, pack.ID)
@@ -689,7 +721,7 @@ func (dump *Dump) EctractAndApplyUpdateIPv6(record *Content, pack *PackedContent
 		}
 	}
 
-	for _, ip6 := range pack.IPv6 {
+	for _, ip6 := range slices.Clone(pack.IPv6) {
 		if _, ok := ipExisted[string(ip6.IPv6)]; !ok {
 			pack.RemoveIPv6(ip6)
 			dump.RemoveFromIPv6Index(string(ip6.IPv6), pack.ID)
@@ -736,7 +768,7 @@ func (dump *Dump) EctractAndApplyUpdateSubnetIPv4(record *Content, pack *PackedC
 		}
 	}
 
-	for _, subnetIPv4 := range pack.SubnetIPv4 {
+	for _, subnetIPv4 := range slices.Clone(pack.SubnetIPv4) {
 		if _, ok := existedSubnetIPv4[subnetIPv4.SubnetIPv4]; !ok {
 			pack.RemoveSubnetIPv4(subnetIPv4)
 			dump.RemoveFromSubnetIPv4Index(subnetIPv4.SubnetIPv4, pack.ID)
@@ -768,7 +800,7 @@ func (dump *Dump) ExtractAndApplySubnetIPv6(record *Content, pack *PackedContent
 	if len(record.SubnetIPv6) > 0 {
 		pack.SubnetIPv6 = record.SubnetIPv6
 		for _, subnet6 := range pack.SubnetIPv6 {
-			dump.InsertToSubnetIPv4Index(subnet6.SubnetIPv6, pack.ID)
+			dump.InsertToSubnetIPv6Index(subnet6.SubnetIPv6, pack.ID)
 		}
 	}
 }
@@ -783,10 +815,10 @@ func (dump *Dump) EctractAndApplyUpdateSubnetIPv6(record *Content, pack *PackedC
 		}
 	}
 
-	for _, subnetIPv6 := range pack.SubnetIPv6 {
+	for _, subnetIPv6 := range slices.Clone(pack.SubnetIPv6) {
 		if _, ok := existedSubnetIPv6[subnetIPv6.SubnetIPv6]; !ok {
 			pack.RemoveSubnetIPv6(subnetIPv6)
-			dump.RemoveFromSubnetIPv4Index(subnetIPv6.SubnetIPv6, pack.ID)
+			dump.RemoveFromSubnetIPv6Index(subnetIPv6.SubnetIPv6, pack.ID)
 		}
 	}
 }
@@ -815,7 +847,7 @@ func (dump *Dump) ExtractAndApplyDomain(record *Content, pack *PackedContent) {
 	if len(record.Domain) > 0 {
 		pack.Domain = record.Domain
 		for _, domain := range pack.Domain {
-			nDomain := NormalizeDomain(domain.Domain)
+			nDomain := normalize.Domain(domain.Domain)
 
 			dump.InsertToDomainIndex(nDomain, pack.ID)
 		}
@@ -828,23 +860,25 @@ func (dump *Dump) EctractAndApplyUpdateDomain(record *Content, pack *PackedConte
 		for _, domain := range record.Domain {
 			pack.InsertDomain(domain)
 
-			nDomain := NormalizeDomain(domain.Domain)
-
-			dump.InsertToDomainIndex(nDomain, pack.ID)
-
-			domainExisted[domain.Domain] = Nothing{}
+			domainExisted[normalize.Domain(domain.Domain)] = Nothing{}
 		}
 	}
 
-	for _, domain := range pack.Domain {
-		if _, ok := domainExisted[domain.Domain]; !ok {
+	// the index is keyed by normalized domains, the other spelling of the same domain keeps it.
+	for _, domain := range slices.Clone(pack.Domain) {
+		nDomain := normalize.Domain(domain.Domain)
+		if _, ok := domainExisted[nDomain]; !ok {
 			pack.RemoveDomain(domain)
-
-			nDomain := NormalizeDomain(domain.Domain)
-
 			dump.RemoveFromDomainIndex(nDomain, pack.ID)
+		} else if !slices.Contains(record.Domain, domain) {
+			pack.RemoveDomain(domain)
 		}
 	}
+
+	// parents and suffixes may be shared with the removed domains, so insert after the removal.
+	for _, domain := range pack.Domain {
+		dump.InsertToDomainIndex(normalize.Domain(domain.Domain), pack.ID)
+	}
 }
 
 func (pack *PackedContent) InsertDomain(domain Domain) {
@@ -871,7 +905,7 @@ func (dump *Dump) ExtractAndApplyURL(record *Content, pack *PackedContent) {
 	if len(record.URL) > 0 {
 		pack.URL = record.URL
 		for _, u := range pack.URL {
-			nURL := NormalizeURL(u.URL)
+			nURL := normalize.URL(u.URL)
 			if strings.HasPrefix(nURL, "https://") {
 				record.HTTPSBlock++
 			}
@@ -891,27 +925,28 @@ func (dump *Dump) EctractAndApplyUpdateURL(record *Content, pack *PackedContent)
 		for _, u := range record.URL {
 			pack.InsertURL(u)
 
-			nURL := NormalizeURL(u.URL)
+			nURL := normalize.URL(u.URL)
 			if strings.HasPrefix(nURL, "https://") {
 				HTTPSBlock++
 			}
 
 			dump.InsertToURLIndex(nURL, pack.ID)
 
-			urlExisted[u.URL] = Nothing{}
+			urlExisted[nURL] = Nothing{}
 		}
 	}
 
 	record.HTTPSBlock = HTTPSBlock
 	pack.BlockType = record.constructBlockType()
 
-	for _, u := range pack.URL {
-		if _, ok := urlExisted[u.URL]; !ok {
+	// the index is keyed by normalized URLs, the other spelling of the same URL keeps it.
+	for _, u := range slices.Clone(pack.URL) {
+		nURL := normalize.URL(u.URL)
+		if _, ok := urlExisted[nURL]; !ok {
 			pack.RemoveURL(u)
-
-			nURL := NormalizeURL(u.URL)
-
 			dump.RemoveFromURLIndex(nURL, pack.ID)
+		} else if !slices.Contains(record.URL, u) {
+			pack.RemoveURL(u)
 		}
 	}
 }
@@ -949,17 +984,34 @@ func newPackedContent(id int32, hash uint64, utime int64, payload []byte) *Packe
 	}
 }
 
-func (v *PackedContent) newPbContent(ip4 uint32, ip6 []byte, domain, url, aggr string) *pb.Content {
+// newPbContent - creates protobuf content with the match reason and the matched key.
+// Legacy ip4, ip6, domain, url and aggr fields are filled from the key too.
+func (v *PackedContent) newPbContent(reason pb.MatchReason, key string) *pb.Content {
 	v0 := pb.Content{}
 	v0.BlockType = v.BlockType
 	v0.RegistryUpdateTime = v.RegistryUpdateTime
 	v0.Id = v.ID
-	v0.Ip4 = ip4
-	v0.Ip6 = ip6
-	v0.Domain = domain
-	v0.Url = url
-	v0.Aggr = aggr
-	v0.Pack = v.Payload
+	v0.Match = reason
+	v0.MatchKey = key
+
+	switch reason {
+	case pb.MatchReason_MATCH_IPV4:
+		v0.Ip4 = IPv4StrToInt(key)
+	case pb.MatchReason_MATCH_IPV6:
+		v0.Ip6 = net.ParseIP(key)
+	case pb.MatchReason_MATCH_DOMAIN, pb.MatchReason_MATCH_DOMAIN_PARENT, pb.MatchReason_MATCH_DOMAIN_SUFFIX:
+		v0.Domain = key
+	case pb.MatchReason_MATCH_URL:
+		v0.Url = key
+	case pb.MatchReason_MATCH_IPV4_SUBNET, pb.MatchReason_MATCH_IPV6_SUBNET:
+		v0.Aggr = key
+	}
+
+	v0.Record = v.Record()
+	if JSONPack {
+		v0.Pack = jsonPack(v0.Record)
+	}
+
 	return &v0
 }
 
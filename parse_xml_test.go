@@ -123,20 +123,21 @@ const (
 )
 
 func Test_Parse(t *testing.T) {
-	var stats ParseStatistics
 	logger.LogInit(os.Stderr, os.Stdout, os.Stderr, os.Stderr)
+	CurrentDump = NewDump()
 	dumpFile := strings.NewReader(xml01)
 	err := Parse(dumpFile)
 	if err != nil {
 		t.Errorf(err.Error())
 	}
 
+	stats := Summary.Load().(*SummaryValues)
 	if stats.MaxItemReferences != 5 ||
 		stats.Count != 5 ||
-		stats.AddCount != 5 ||
-		stats.UpdateCount != 0 ||
-		stats.RemoveCount != 0 {
-		t.Errorf("Stat error: %v\n", Summary)
+		stats.Added != 5 ||
+		stats.Updated != 0 ||
+		stats.Removed != 0 {
+		t.Errorf("Stat error: %v\n", stats)
 	}
 
 	if len(CurrentDump.IPv4Index) != 13 ||
@@ -159,6 +160,15 @@ func Test_Parse(t *testing.T) {
 	if err != nil {
 		t.Errorf(err.Error())
 	}
+
+	stats = Summary.Load().(*SummaryValues)
+	if stats.Count != 5 ||
+		stats.Added != 0 ||
+		stats.Updated != 5 ||
+		stats.Removed != 0 {
+		t.Errorf("Stat error: %v\n", stats)
+	}
+
 	fmt.Printf("IP4:\n%v\n", CurrentDump.IPv4Index)
 	for k := range CurrentDump.ContentIndex {
 		fmt.Printf("%d ", k)
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"runtime"
 	"time"
@@ -10,7 +12,12 @@ import (
 	"github.com/usher2/u2ckdump/internal/logger"
 )
 
-// DumpPoll - poll "vygruzki" service for new dumps.
+// Errors
+var (
+	ErrEmptyDumpID = errors.New("last dump id is empty")
+)
+
+// DumpPoll - poll "vygruzki" service for new dumps, the poll is skipped while paused.
 func DumpPoll(s *grpc.Server, done chan<- struct{}, kill <-chan struct{}, url, token, dir string, d time.Duration) {
 	timer := time.NewTimer(time.Millisecond)
 	defer timer.Stop()
@@ -18,7 +25,13 @@ func DumpPoll(s *grpc.Server, done chan<- struct{}, kill <-chan struct{}, url, t
 	for {
 		select {
 		case <-timer.C:
-			DumpRefresh(url, token, dir)
+			if !DumpStatus.Paused() {
+				refreshMutex.Lock()
+				DumpStatus.begin(sourcePoll, "")
+				DumpStatus.end(DumpRefresh(url, token, dir))
+				refreshMutex.Unlock()
+			}
+
 			timer.Reset(d * time.Second)
 		case <-kill:
 			close(done)
@@ -28,24 +41,21 @@ func DumpPoll(s *grpc.Server, done chan<- struct{}, kill <-chan struct{}, url, t
 	}
 }
 
-// DumpRefresh - try to fetch new dump.
-func DumpRefresh(url, token, dir string) {
+// DumpRefresh - try to fetch new dump, the caller holds refreshMutex.
+func DumpRefresh(url, token, dir string) error {
 	ts := time.Now().Unix()
 
 	lastDump, err := GetLastDumpID(ts, url, token)
 	if err != nil {
-		logger.Error.Printf("Can't get last dump id: %s\n", err.Error())
-
-		return
+		return fmt.Errorf("get last dump id: %w", err)
 	}
 
 	if lastDump.ID == "" {
-		logger.Error.Println("Last dump Id is empty...")
-
-		return
+		return ErrEmptyDumpID
 	}
 
 	logger.Info.Printf("Last dump id: %s\n", lastDump.ID)
+	DumpStatus.attempt(lastDump.ID)
 
 	cachedDump, err := ReadCurrentDumpID(dir + "/current")
 	if err != nil {
@@ -69,46 +79,21 @@ func DumpRefresh(url, token, dir string) {
 
 		err := FetchDump(lastDump.ID, dir+"/dump.zip", url, token)
 		if err != nil {
-			logger.Error.Printf("Can't fetch last dump: %s\n", err.Error())
-
-			return
+			return fmt.Errorf("fetch last dump: %w", err)
 		}
 
 		logger.Info.Println("Last dump fetched")
 
-		err = DumpUnzip(dir+"/dump.zip", dir+"/dump.xml")
+		err = applyDumpArchive(dir+"/dump.zip", dir+"/dump.xml")
 		if err != nil {
-			logger.Error.Printf("Can't extract last dump: %s\n", err.Error())
-
-			return
-		}
-
-		logger.Info.Println("Last dump extracted")
-
-		// parse xml
-		dumpFile, err := os.Open(dir + "/dump.xml")
-		if err != nil {
-			logger.Error.Printf("Can't open dump file: %s\n", err.Error())
-
-			return
-		}
-
-		defer dumpFile.Close()
-
-		err = Parse(dumpFile)
-		if err != nil {
-			logger.Error.Printf("Parse error: %s\n", err.Error())
-
-			return
+			return err
 		}
 
-		logger.Info.Printf("Dump parsed")
+		DumpStatus.applied(lastDump.ID)
 
 		err = WriteCurrentDumpID(dir+"/current", lastDump)
 		if err != nil {
-			logger.Error.Printf("Can't write currentdump file: %s\n", err.Error())
-
-			return
+			return fmt.Errorf("write current dump file: %w", err)
 		}
 
 		logger.Info.Println("Last dump metainfo saved")
@@ -116,7 +101,43 @@ func DumpRefresh(url, token, dir string) {
 		logger.Info.Printf("Not changed, but new dump metainfo")
 
 		UpdateDumpTime(lastDump.UpdateTime)
+		DumpStatus.applied(lastDump.ID)
 	default:
 		logger.Info.Printf("No new dump")
 	}
+
+	return nil
+}
+
+// applyDumpArchive - unzips the archive, checks and parses the dump, the caller holds refreshMutex.
+// The archive is verified by the zip checksums on extraction.
+func applyDumpArchive(src, filename string) error {
+	err := DumpUnzip(src, filename)
+	if err != nil {
+		return fmt.Errorf("extract dump: %w", err)
+	}
+
+	logger.Info.Println("Dump extracted")
+
+	err = CheckDumpFile(filename)
+	if err != nil {
+		return err
+	}
+
+	// parse xml
+	dumpFile, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("open dump file: %w", err)
+	}
+
+	defer dumpFile.Close()
+
+	err = Parse(dumpFile)
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	logger.Info.Printf("Dump parsed")
+
+	return nil
 }
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Facet dimensions.
+const (
+	facetOrg        = "org"
+	facetEntryType  = "entry_type"
+	facetBlockType  = "block_type"
+	facetHasIPs     = "has_ips"
+	facetHasURLs    = "has_urls"
+	facetHasDomains = "has_domains"
+)
+
+// All query methods below expect the dump to be read locked by the caller.
+
+// searchQuery - search records matched by any of the clauses.
+func (dump *Dump) searchQuery(clauses []*pb.QueryClause) []Match {
+	matches := newMatchSet(0)
+
+	for _, clause := range clauses {
+		candidates := dump.clauseCandidates(clause)
+
+		ids := make(IntArrayStorage, 0, len(candidates))
+
+		for _, id := range candidates {
+			if cont, ok := dump.ContentIndex[id]; ok && clauseMatch(clause, cont) {
+				ids = append(ids, id)
+			}
+		}
+
+		matches.add(ids, pb.MatchReason_MATCH_QUERY, "")
+	}
+
+	return matches.list
+}
+
+// clauseCandidates - narrows the records with org and entry type indexes, falls back to all records.
+func (dump *Dump) clauseCandidates(clause *pb.QueryClause) IntArrayStorage {
+	var candidates IntArrayStorage
+
+	switch {
+	case len(clause.GetOrgs()) > 0:
+		for _, org := range clause.GetOrgs() {
+			candidates = append(candidates, dump.orgIndex[org]...)
+		}
+	case len(clause.GetEntryTypes()) > 0:
+		for _, entryType := range clause.GetEntryTypes() {
+			candidates = append(candidates, dump.entryTypeIndex[entryType]...)
+		}
+	default:
+		candidates = make(IntArrayStorage, 0, len(dump.ContentIndex))
+		for id := range dump.ContentIndex {
+			candidates = append(candidates, id)
+		}
+	}
+
+	// stable order for ORDER_MATCH pagination.
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	return candidates
+}
+
+// clauseMatch - checks all clause conditions against the record.
+func clauseMatch(clause *pb.QueryClause, cont *PackedContent) bool {
+	if len(clause.GetOrgs()) > 0 && !containsString(clause.GetOrgs(), cont.DecisionOrg) {
+		return false
+	}
+
+	if len(clause.GetEntryTypes()) > 0 && !containsString(clause.GetEntryTypes(), cont.EntryTypeString) {
+		return false
+	}
+
+	if len(clause.GetBlockTypes()) > 0 && !containsInt32(clause.GetBlockTypes(), cont.BlockType) {
+		return false
+	}
+
+	if !inTimeRange(clause.GetDecisionDate(), cont.DecisionDate) ||
+		!inTimeRange(clause.GetIncludeTime(), cont.IncludeTime) {
+		return false
+	}
+
+	return presenceMatch(clause.GetHasIPs(), cont.hasIPs()) &&
+		presenceMatch(clause.GetHasURLs(), len(cont.URL) > 0) &&
+		presenceMatch(clause.GetHasDomains(), len(cont.Domain) > 0)
+}
+
+func inTimeRange(r *pb.TimeRange, t int64) bool {
+	if r.GetFrom() != 0 && t < r.GetFrom() {
+		return false
+	}
+
+	if r.GetTo() != 0 && t >= r.GetTo() {
+		return false
+	}
+
+	return true
+}
+
+func presenceMatch(p pb.Presence, has bool) bool {
+	switch p {
+	case pb.Presence_PRESENCE_YES:
+		return has
+	case pb.Presence_PRESENCE_NO:
+		return !has
+	default:
+		return true
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsInt32(list []int32, x int32) bool {
+	for _, v := range list {
+		if v == x {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasIPs - the record has any IP address or subnet.
+func (pack *PackedContent) hasIPs() bool {
+	return len(pack.IPv4) > 0 || len(pack.IPv6) > 0 || len(pack.SubnetIPv4) > 0 || len(pack.SubnetIPv6) > 0
+}
+
+// facets - counts matched records for every dimension.
+func (dump *Dump) facets(matches []Match) []*pb.Facet {
+	dimensions := []string{facetOrg, facetEntryType, facetBlockType, facetHasIPs, facetHasURLs, facetHasDomains}
+	counts := make(map[string]map[string]int32, len(dimensions))
+
+	for _, dimension := range dimensions {
+		counts[dimension] = make(map[string]int32)
+	}
+
+	for _, m := range matches {
+		cont, ok := dump.content(m)
+		if !ok {
+			continue
+		}
+
+		counts[facetOrg][cont.DecisionOrg]++
+		counts[facetEntryType][cont.EntryTypeString]++
+		counts[facetBlockType][blockTypeName(cont.BlockType)]++
+		counts[facetHasIPs][strconv.FormatBool(cont.hasIPs())]++
+		counts[facetHasURLs][strconv.FormatBool(len(cont.URL) > 0)]++
+		counts[facetHasDomains][strconv.FormatBool(len(cont.Domain) > 0)]++
+	}
+
+	facets := make([]*pb.Facet, 0, len(dimensions))
+	for _, dimension := range dimensions {
+		facets = append(facets, &pb.Facet{Dimension: dimension, Counts: counts[dimension]})
+	}
+
+	return facets
+}
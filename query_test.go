@@ -0,0 +1,88 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestSearchQuery(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		clauses []*pb.QueryClause
+		want    []int32
+	}{
+		{
+			name: "OR of clauses",
+			clauses: []*pb.QueryClause{
+				{BlockTypes: []int32{BlockTypeIP}, HasDomains: pb.Presence_PRESENCE_NO},
+				{Orgs: []string{"ONE"}},
+			},
+			want: []int32{333, 444, 111},
+		},
+		{
+			name: "AND inside clause",
+			clauses: []*pb.QueryClause{
+				{EntryTypes: []string{"15_1"}, BlockTypes: []int32{BlockTypeDomain}, Orgs: []string{"TWO", "THREE"}},
+			},
+			want: []int32{222},
+		},
+		{
+			name: "include time range",
+			clauses: []*pb.QueryClause{
+				{IncludeTime: &pb.TimeRange{From: parseMoscowTime("2001-01-01T03:00:00")}},
+			},
+			want: []int32{333, 444, 555},
+		},
+		{
+			name: "decision date range",
+			clauses: []*pb.QueryClause{
+				{DecisionDate: &pb.TimeRange{From: parseDecisionTime("2001-01-01"), To: parseDecisionTime("2001-01-05")}},
+			},
+			want: []int32{333, 444},
+		},
+		{
+			name: "has URLs",
+			clauses: []*pb.QueryClause{
+				{HasURLs: pb.Presence_PRESENCE_YES},
+			},
+			want: []int32{111},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CurrentDump.searchQuery(tt.clauses)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+
+			for i := range got {
+				if got[i].ID != tt.want[i] || got[i].Reason != pb.MatchReason_MATCH_QUERY {
+					t.Errorf("Expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+
+	facets := CurrentDump.facets(CurrentDump.searchQuery([]*pb.QueryClause{{}}))
+	for _, facet := range facets {
+		switch facet.Dimension {
+		case facetBlockType:
+			if facet.Counts["ip"] != 2 || facet.Counts["domain"] != 2 || facet.Counts["https"] != 1 {
+				t.Errorf("Block type facet error: %v", facet.Counts)
+			}
+		case facetHasURLs:
+			if facet.Counts["true"] != 1 || facet.Counts["false"] != 4 {
+				t.Errorf("Has URLs facet error: %v", facet.Counts)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// JSONPack - fill deprecated JSON pack field in results for old clients.
+var JSONPack bool
+
+// Marshal - encodes content to protobuf ContentRecord.
+func (record *Content) Marshal() []byte {
+	b, err := proto.Marshal(record.newPbRecord())
+	if err != nil {
+		logger.Error.Printf("Error encoding: %s\n", err.Error())
+	}
+
+	return b
+}
+
+// newPbRecord - converts content to protobuf ContentRecord.
+func (record *Content) newPbRecord() *pb.ContentRecord {
+	rec := &pb.ContentRecord{
+		Id:           record.ID,
+		EntryType:    record.EntryType,
+		EntryTypeKey: entryTypeKey(record.EntryType, record.Decision.Org, record.Decision.Number),
+		UrgencyType:  record.UrgencyType,
+		Decision: &pb.Decision{
+			Date:   record.Decision.Date,
+			Number: record.Decision.Number,
+			Org:    record.Decision.Org,
+		},
+		IncludeTime: record.IncludeTime,
+		Ts:          record.Ts,
+		BlockType:   record.BlockType,
+		Hash:        record.Hash,
+		HttpsBlock:  int32(record.HTTPSBlock),
+		RecordHash:  record.RecordHash,
+	}
+
+	for _, u := range record.URL {
+		rec.Urls = append(rec.Urls, &pb.TimedString{Value: u.URL, Ts: u.Ts})
+	}
+
+	for _, domain := range record.Domain {
+		rec.Domains = append(rec.Domains, &pb.TimedString{Value: domain.Domain, Ts: domain.Ts})
+	}
+
+	for _, ip4 := range record.IPv4 {
+		rec.Ip4 = append(rec.Ip4, &pb.TimedIPv4{Ip4: ip4.IPv4, Ts: ip4.Ts})
+	}
+
+	for _, ip6 := range record.IPv6 {
+		rec.Ip6 = append(rec.Ip6, &pb.TimedIPv6{Ip6: ip6.IPv6, Ts: ip6.Ts})
+	}
+
+	for _, subnet4 := range record.SubnetIPv4 {
+		rec.Subnet4 = append(rec.Subnet4, &pb.TimedString{Value: subnet4.SubnetIPv4, Ts: subnet4.Ts})
+	}
+
+	for _, subnet6 := range record.SubnetIPv6 {
+		rec.Subnet6 = append(rec.Subnet6, &pb.TimedString{Value: subnet6.SubnetIPv6, Ts: subnet6.Ts})
+	}
+
+	return rec
+}
+
+// newContentFromPbRecord - converts protobuf ContentRecord back to content.
+func newContentFromPbRecord(rec *pb.ContentRecord) *Content {
+	record := &Content{
+		ID:          rec.GetId(),
+		EntryType:   rec.GetEntryType(),
+		UrgencyType: rec.GetUrgencyType(),
+		Decision: Decision{
+			Date:   rec.GetDecision().GetDate(),
+			Number: rec.GetDecision().GetNumber(),
+			Org:    rec.GetDecision().GetOrg(),
+		},
+		IncludeTime: rec.GetIncludeTime(),
+		Ts:          rec.GetTs(),
+		BlockType:   rec.GetBlockType(),
+		Hash:        rec.GetHash(),
+		HTTPSBlock:  int(rec.GetHttpsBlock()),
+		RecordHash:  rec.GetRecordHash(),
+	}
+
+	for _, u := range rec.GetUrls() {
+		record.URL = append(record.URL, URL{URL: u.GetValue(), Ts: u.GetTs()})
+	}
+
+	for _, domain := range rec.GetDomains() {
+		record.Domain = append(record.Domain, Domain{Domain: domain.GetValue(), Ts: domain.GetTs()})
+	}
+
+	for _, ip4 := range rec.GetIp4() {
+		record.IPv4 = append(record.IPv4, IPv4{IPv4: ip4.GetIp4(), Ts: ip4.GetTs()})
+	}
+
+	for _, ip6 := range rec.GetIp6() {
+		record.IPv6 = append(record.IPv6, IPv6{IPv6: ip6.GetIp6(), Ts: ip6.GetTs()})
+	}
+
+	for _, subnet4 := range rec.GetSubnet4() {
+		record.SubnetIPv4 = append(record.SubnetIPv4, SubnetIPv4{SubnetIPv4: subnet4.GetValue(), Ts: subnet4.GetTs()})
+	}
+
+	for _, subnet6 := range rec.GetSubnet6() {
+		record.SubnetIPv6 = append(record.SubnetIPv6, SubnetIPv6{SubnetIPv6: subnet6.GetValue(), Ts: subnet6.GetTs()})
+	}
+
+	return record
+}
+
+// Record - decodes the packed payload to protobuf ContentRecord.
+func (pack *PackedContent) Record() *pb.ContentRecord {
+	rec := &pb.ContentRecord{}
+
+	if err := proto.Unmarshal(pack.Payload, rec); err != nil {
+		logger.Error.Printf("Error decoding #%d: %s\n", pack.ID, err.Error())
+	}
+
+	return rec
+}
+
+// jsonPack - encodes the packed payload to the deprecated JSON pack.
+func jsonPack(rec *pb.ContentRecord) []byte {
+	b, err := json.Marshal(newContentFromPbRecord(rec))
+	if err != nil {
+		logger.Error.Printf("Error encoding: %s\n", err.Error())
+	}
+
+	return b
+}
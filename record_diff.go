@@ -0,0 +1,92 @@
+package main
+
+import (
+	"net"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// diffRecords - field level changes between two versions of the record.
+func diffRecords(prev, cur *pb.ContentRecord) *pb.RecordDiff {
+	diff := &pb.RecordDiff{}
+
+	diff.Ip4Added, diff.Ip4Removed = diffStrings(ip4Strings(prev.GetIp4()), ip4Strings(cur.GetIp4()))
+	diff.Ip6Added, diff.Ip6Removed = diffStrings(ip6Strings(prev.GetIp6()), ip6Strings(cur.GetIp6()))
+	diff.Subnet4Added, diff.Subnet4Removed = diffStrings(timedStrings(prev.GetSubnet4()), timedStrings(cur.GetSubnet4()))
+	diff.Subnet6Added, diff.Subnet6Removed = diffStrings(timedStrings(prev.GetSubnet6()), timedStrings(cur.GetSubnet6()))
+	diff.DomainAdded, diff.DomainRemoved = diffStrings(timedStrings(prev.GetDomains()), timedStrings(cur.GetDomains()))
+	diff.UrlAdded, diff.UrlRemoved = diffStrings(timedStrings(prev.GetUrls()), timedStrings(cur.GetUrls()))
+
+	if decisionChanged(prev.GetDecision(), cur.GetDecision()) {
+		diff.DecisionChanged = true
+		diff.PrevDecision = prev.GetDecision()
+	}
+
+	if prev.GetEntryType() != cur.GetEntryType() {
+		diff.EntryTypeChanged = true
+		diff.PrevEntryType = prev.GetEntryType()
+	}
+
+	if prev.GetBlockType() != cur.GetBlockType() {
+		diff.BlockTypeChanged = true
+		diff.PrevBlockType = prev.GetBlockType()
+	}
+
+	return diff
+}
+
+func decisionChanged(prev, cur *pb.Decision) bool {
+	return prev.GetOrg() != cur.GetOrg() || prev.GetNumber() != cur.GetNumber() || prev.GetDate() != cur.GetDate()
+}
+
+// diffStrings - values added to and removed from the list, in the list order.
+func diffStrings(prev, cur []string) (added, removed []string) {
+	existed := make(map[string]Nothing, len(prev))
+	for _, s := range prev {
+		existed[s] = Nothing{}
+	}
+
+	present := make(map[string]Nothing, len(cur))
+	for _, s := range cur {
+		present[s] = Nothing{}
+
+		if _, ok := existed[s]; !ok {
+			added = append(added, s)
+		}
+	}
+
+	for _, s := range prev {
+		if _, ok := present[s]; !ok {
+			removed = append(removed, s)
+		}
+	}
+
+	return added, removed
+}
+
+func ip4Strings(list []*pb.TimedIPv4) []string {
+	res := make([]string, 0, len(list))
+	for _, ip4 := range list {
+		res = append(res, int2Ip4(ip4.GetIp4()))
+	}
+
+	return res
+}
+
+func ip6Strings(list []*pb.TimedIPv6) []string {
+	res := make([]string, 0, len(list))
+	for _, ip6 := range list {
+		res = append(res, net.IP(ip6.GetIp6()).String())
+	}
+
+	return res
+}
+
+func timedStrings(list []*pb.TimedString) []string {
+	res := make([]string, 0, len(list))
+	for _, s := range list {
+		res = append(res, s.GetValue())
+	}
+
+	return res
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestContentRecord(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := CurrentDump.ContentIndex[111].Record()
+
+	if rec.GetId() != 111 ||
+		rec.GetEntryTypeKey() != "15_1" ||
+		rec.GetDecision().GetOrg() != "ONE" ||
+		rec.GetDecision().GetNumber() != "1/1/11-1111" ||
+		rec.GetIncludeTime() != parseMoscowTime("2001-01-01T01:01:01") ||
+		len(rec.GetUrls()) != 3 ||
+		len(rec.GetDomains()) != 1 ||
+		len(rec.GetIp4()) != 3 ||
+		len(rec.GetIp6()) != 3 ||
+		rec.GetHttpsBlock() != 1 ||
+		rec.GetRecordHash() != CurrentDump.ContentIndex[111].RecordHash {
+		t.Errorf("Record error: %v", rec)
+	}
+
+	content := Content{}
+	if err := json.Unmarshal(jsonPack(rec), &content); err != nil {
+		t.Fatal(err)
+	}
+
+	if content.ID != 111 || content.Decision.Org != "ONE" || len(content.URL) != 3 || content.IPv4[0].IPv4 != IPv4StrToInt("192.168.1.11") {
+		t.Errorf("JSON pack error: %v", content)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"archive/zip"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// archiveNameLayout - archived dump file name, e.g. dump-2018-04-16T23:46:00+0300.zip.
+const archiveNameLayout = "dump-2006-01-02T15:04:05-0700.zip"
+
+// Errors
+var (
+	ErrNoDumpInArchive   = errors.New("no dump.xml in archive")
+	ErrReplayNotRecorded = errors.New("dump is not recorded in the history")
+)
+
+// dumpArchive - archived dump with the time from its name.
+type dumpArchive struct {
+	Filename string
+	Time     time.Time
+}
+
+// replayMain - replay command: feeds archived dumps through Parse and records the history and the series.
+func replayMain(args []string) int {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	confArchiveDir := fs.String("d", "res", "Archived dumps dir")
+	confHistoryFile := fs.String("history", "history.db", "Registry history file to append to")
+	confSeriesFile := fs.String("series", "series.jsonl", "Summary time series file to append to")
+	confLogLevel := fs.String("l", "Info", "Logging level")
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	history, err := LoadHistory(*confHistoryFile)
+	if err != nil {
+		logger.Error.Printf("Can't load history: %s\n", err.Error())
+
+		return 1
+	}
+
+	series, err := LoadSeries(*confSeriesFile)
+	if err != nil {
+		logger.Error.Printf("Can't load series: %s\n", err.Error())
+
+		return 1
+	}
+
+	CurrentDump = NewDump()
+	CurrentDump.history = history
+	StatSeries = series
+
+	n, err := ReplayArchives(*confArchiveDir)
+	if err != nil {
+		logger.Error.Printf("Replay error: %s\n", err.Error())
+
+		return 1
+	}
+
+	logger.Info.Printf("Replayed %d dumps, history has %d dumps and %d records\n", n, len(history.Times), len(history.Versions))
+
+	return 0
+}
+
+// listDumpArchives - archived dumps of the dir in chronological order.
+// Files not named like archived dumps are skipped.
+func listDumpArchives(dir string) ([]dumpArchive, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read dir: %w", err)
+	}
+
+	archives := make([]dumpArchive, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		t, err := time.Parse(archiveNameLayout, entry.Name())
+		if err != nil {
+			logger.Debug.Printf("Not an archived dump, skipped: %s\n", entry.Name())
+
+			continue
+		}
+
+		archives = append(archives, dumpArchive{Filename: filepath.Join(dir, entry.Name()), Time: t})
+	}
+
+	sort.Slice(archives, func(i, j int) bool { return archives[i].Time.Before(archives[j].Time) })
+
+	return archives, nil
+}
+
+// ReplayArchives - parses archived dumps of the dir into CurrentDump in chronological order.
+// History is recorded in time order only, archives not newer than the history are skipped,
+// so the dir is backfilled incrementally.
+func ReplayArchives(dir string) (int, error) {
+	archives, err := listDumpArchives(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	history := CurrentDump.history
+
+	last := history.lastTime()
+	skip := sort.Search(len(archives), func(i int) bool { return archives[i].Time.Unix() > last })
+
+	if skip > 0 {
+		logger.Info.Printf("Skipped %d archives recorded in the history till %s\n", skip, time.Unix(last, 0).Format(time.RFC3339))
+	}
+
+	archives = archives[skip:]
+
+	for i, archive := range archives {
+		logger.Info.Printf("Replaying %d/%d: %s\n", i+1, len(archives), archive.Filename)
+
+		if err := parseDumpArchive(archive.Filename); err != nil {
+			return i, fmt.Errorf("%s: %w", archive.Filename, err)
+		}
+
+		// the registry time of the dump may be older than the name says.
+		if history != nil && history.lastTime() != CurrentDump.utime {
+			return i, fmt.Errorf("%s: %w", archive.Filename, ErrReplayNotRecorded)
+		}
+	}
+
+	return len(archives), nil
+}
+
+// parseDumpArchive - parses dump.xml straight from the zip archive.
+func parseDumpArchive(filename string) error {
+	r, err := zip.OpenReader(filename)
+	if err != nil {
+		return fmt.Errorf("open zip arch: %w", err)
+	}
+
+	defer r.Close()
+
+	for _, f := range r.File {
+		if f.Name != "dump.xml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("open zipped file: %w", err)
+		}
+
+		defer rc.Close()
+
+		if err := Parse(sizedReader{Reader: rc, size: int64(f.UncompressedSize64)}); err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		return nil
+	}
+
+	return ErrNoDumpInArchive
+}
+
+// parseDumpFile - parses the zipped or the plain dump into CurrentDump.
+func parseDumpFile(filename string) error {
+	if strings.HasSuffix(filename, ".zip") {
+		return parseDumpArchive(filename)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("open dump: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := Parse(f); err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	return nil
+}
+
+// loadDump - the history snapshot at the time, empty time is the latest, or the parsed dump file.
+// The parsed dump is saved as the snapshot file when saveFile is not empty.
+func loadDump(dumpFile, historyFile, at, saveFile string) (*Dump, error) {
+	if historyFile == "" {
+		CurrentDump = NewDump()
+
+		if saveFile != "" {
+			tmpfilename := fmt.Sprintf("%s-temp", saveFile)
+
+			if err := os.Remove(tmpfilename); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("remove tmpfile: %w", err)
+			}
+
+			CurrentDump.history = NewHistory(tmpfilename)
+		}
+
+		if err := parseDumpFile(dumpFile); err != nil {
+			return nil, err
+		}
+
+		if saveFile != "" {
+			if err := os.Rename(fmt.Sprintf("%s-temp", saveFile), saveFile); err != nil {
+				return nil, fmt.Errorf("file rename: %w", err)
+			}
+		}
+
+		return CurrentDump, nil
+	}
+
+	asOf := int64(math.MaxInt64)
+
+	if at != "" {
+		t, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot time: %w", err)
+		}
+
+		asOf = t.Unix()
+	}
+
+	history, err := LoadHistory(historyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.Snapshot(asOf)
+}
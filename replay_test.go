@@ -0,0 +1,77 @@
+package main
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeDumpArchive - zips the dump as dump.xml.
+func writeDumpArchive(t *testing.T, filename, dump string) {
+	t.Helper()
+
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+
+	fw, err := w.Create("dump.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fw.Write([]byte(dump)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplayArchives(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "history")
+
+	// names are out of order in the dir listing.
+	writeDumpArchive(t, filepath.Join(dir, "dump-2011-01-02T01:01:01+0300.zip"), dumpWithout("2011-01-02T01:01:01+03:00", "555"))
+	writeDumpArchive(t, filepath.Join(dir, "dump-2011-01-01T01:01:01+0300.zip"), xml01)
+	writeDumpArchive(t, filepath.Join(dir, "dump.zip"), xml01)
+
+	CurrentDump = NewDump()
+	CurrentDump.history = NewHistory(filename)
+
+	n, err := ReplayArchives(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != 2 {
+		t.Errorf("Expected 2 replayed dumps, got %d", n)
+	}
+
+	// replay again: the recorded archives are skipped, the new one is replayed.
+	writeDumpArchive(t, filepath.Join(dir, "dump-2011-01-03T01:01:01+0300.zip"), dumpWithout("2011-01-03T01:01:01+03:00", "555"))
+
+	if n, err := ReplayArchives(dir); err != nil || n != 1 {
+		t.Fatalf("Expected 1 replayed dump, got %d, %v", n, err)
+	}
+
+	history, err := LoadHistory(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history.Times) != 3 || history.Times[0] != parseRFC3339Time("2011-01-01T01:01:01+03:00") {
+		t.Errorf("History times error: %v", history.Times)
+	}
+
+	if versions := history.Versions[555]; len(versions) != 1 || versions[0].Until != history.Times[1] {
+		t.Errorf("Expected 555 removed by the second dump, got %v", versions)
+	}
+}
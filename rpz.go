@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// RPZ defaults.
+const (
+	rpzDefaultTTL = 300
+	rpzNXDOMAIN   = "."
+)
+
+// Errors
+var (
+	ErrBadRPZZone = errors.New("bad rpz zone")
+)
+
+// rpzRecord - RPZ trigger with the action, the owner is relative to the zone origin.
+type rpzRecord struct {
+	Owner string
+	Type  string
+	Data  string
+}
+
+func (r rpzRecord) String() string {
+	return r.Owner + " " + r.Type + " " + r.Data
+}
+
+// rpzOptions - what to put to the zone.
+type rpzOptions struct {
+	Origin     string
+	Redirect   string // IP address, host name or empty for NXDOMAIN.
+	URLDomains bool   // domains of url and https block records.
+}
+
+// rpzAction - action records for the owner.
+func (opts *rpzOptions) rpzAction(owner string) []rpzRecord {
+	if opts.Redirect == "" {
+		return []rpzRecord{{Owner: owner, Type: "CNAME", Data: rpzNXDOMAIN}}
+	}
+
+	var records []rpzRecord
+
+	for _, redirect := range splitList(opts.Redirect) {
+		addr, err := netip.ParseAddr(redirect)
+
+		switch {
+		case err != nil:
+			records = append(records, rpzRecord{Owner: owner, Type: "CNAME", Data: strings.TrimSuffix(redirect, ".") + "."})
+		case addr.Is4():
+			records = append(records, rpzRecord{Owner: owner, Type: "A", Data: addr.String()})
+		default:
+			records = append(records, rpzRecord{Owner: owner, Type: "AAAA", Data: addr.String()})
+		}
+	}
+
+	return records
+}
+
+// rpzRecords - sorted zone records from the domain index.
+// Domain records give the exact trigger, domain-mask records give the wildcard trigger too.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) rpzRecords(opts *rpzOptions) []rpzRecord {
+	var records []rpzRecord
+
+	for domain, ids := range dump.domainIndex {
+		if !isDomainName(domain) {
+			logger.Debug.Printf("Not a domain name, skipped: %s\n", domain)
+
+			continue
+		}
+
+		var exact, wildcard bool
+
+		for _, id := range ids {
+			cont, ok := dump.ContentIndex[id]
+			if !ok {
+				continue
+			}
+
+			switch cont.BlockType {
+			case BlockTypeDomain:
+				exact = true
+			case BlockTypeMask:
+				exact, wildcard = true, true
+			case BlockTypeURL, BlockTypeHTTPS:
+				exact = exact || opts.URLDomains
+			}
+		}
+
+		if exact {
+			records = append(records, opts.rpzAction(domain)...)
+		}
+
+		if wildcard {
+			records = append(records, opts.rpzAction("*."+domain)...)
+		}
+	}
+
+	sortRPZRecords(records)
+
+	return records
+}
+
+func sortRPZRecords(records []rpzRecord) {
+	sort.Slice(records, func(i, j int) bool { return rpzLess(records[i], records[j]) })
+}
+
+// rpzSOA - apex SOA record, serial is the registry update time.
+func rpzSOA(serial uint32) rpzRecord {
+	return rpzRecord{Owner: "@", Type: "SOA", Data: fmt.Sprintf("localhost. root.localhost. %d 3600 600 86400 %d", serial, rpzDefaultTTL)}
+}
+
+// writeRPZZone - RPZ zone file.
+func writeRPZZone(w io.Writer, origin string, serial uint32, records []rpzRecord) {
+	fmt.Fprintf(w, "$ORIGIN %s.\n", strings.TrimSuffix(origin, "."))
+	fmt.Fprintf(w, "$TTL %d\n", rpzDefaultTTL)
+	fmt.Fprintln(w, rpzSOA(serial))
+	fmt.Fprintln(w, "@ NS localhost.")
+
+	for _, r := range records {
+		fmt.Fprintln(w, r)
+	}
+}
+
+// readRPZZone - serial and records of the zone written by writeRPZZone.
+func readRPZZone(r io.Reader) (uint32, []rpzRecord, error) {
+	var (
+		serial  uint32
+		records []rpzRecord
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "$") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return 0, nil, fmt.Errorf("%w: %s", ErrBadRPZZone, line)
+		}
+
+		switch fields[1] {
+		case "SOA":
+			if len(fields) < 5 {
+				return 0, nil, fmt.Errorf("%w: %s", ErrBadRPZZone, line)
+			}
+
+			n, err := strconv.ParseUint(fields[4], 10, 32)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: serial: %w", ErrBadRPZZone, err)
+			}
+
+			serial = uint32(n)
+		case "NS":
+		default:
+			records = append(records, rpzRecord{Owner: fields[0], Type: fields[1], Data: strings.Join(fields[2:], " ")})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, nil, fmt.Errorf("read zone: %w", err)
+	}
+
+	sortRPZRecords(records)
+
+	return serial, records, nil
+}
+
+// diffRPZ - records deleted and added between the sorted zones.
+func diffRPZ(prev, cur []rpzRecord) (deleted, added []rpzRecord) {
+	i, j := 0, 0
+
+	for i < len(prev) || j < len(cur) {
+		switch {
+		case j == len(cur) || (i < len(prev) && rpzLess(prev[i], cur[j])):
+			deleted = append(deleted, prev[i])
+			i++
+		case i == len(prev) || rpzLess(cur[j], prev[i]):
+			added = append(added, cur[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return deleted, added
+}
+
+func rpzLess(a, b rpzRecord) bool {
+	if a.Owner != b.Owner {
+		return a.Owner < b.Owner
+	}
+
+	if a.Type != b.Type {
+		return a.Type < b.Type
+	}
+
+	return a.Data < b.Data
+}
+
+// writeRPZDiff - IXFR style difference (RFC 1995): new SOA, old SOA, deleted records, new SOA, added records, new SOA.
+func writeRPZDiff(w io.Writer, origin string, prevSerial, serial uint32, deleted, added []rpzRecord) {
+	fmt.Fprintf(w, "$ORIGIN %s.\n", strings.TrimSuffix(origin, "."))
+	fmt.Fprintf(w, "$TTL %d\n", rpzDefaultTTL)
+	fmt.Fprintln(w, rpzSOA(serial))
+	fmt.Fprintln(w, rpzSOA(prevSerial))
+
+	for _, r := range deleted {
+		fmt.Fprintln(w, r)
+	}
+
+	fmt.Fprintln(w, rpzSOA(serial))
+
+	for _, r := range added {
+		fmt.Fprintln(w, r)
+	}
+
+	fmt.Fprintln(w, rpzSOA(serial))
+}
+
+// rpzMain - rpz command: parses the dump and writes the RPZ zone and the difference with the previous zone.
+func rpzMain(args []string) int {
+	fs := flag.NewFlagSet("rpz", flag.ExitOnError)
+	confDumpFile := fs.String("i", "res/dump.xml", "Dump file, zipped or plain")
+	confZoneFile := fs.String("o", "rpz.zone", "Zone file, the previous zone is read from it for the difference")
+	confDiffFile := fs.String("diff", "", "IXFR style difference file, empty disables")
+	confOrigin := fs.String("origin", "rpz.local", "Zone origin")
+	confRedirect := fs.String("redirect", "", "Comma separated block page addresses or the host name, empty is NXDOMAIN")
+	confURLDomains := fs.Bool("url-domains", false, "Add domains of URL and HTTPS block records")
+	confLogLevel := fs.String("l", "Error", "Logging level")
+	fs.Parse(args)
+
+	logInit(*confLogLevel)
+
+	opts := &rpzOptions{Origin: *confOrigin, Redirect: *confRedirect, URLDomains: *confURLDomains}
+
+	var (
+		prevSerial  uint32
+		prevRecords []rpzRecord
+	)
+
+	if *confDiffFile != "" {
+		if f, err := os.Open(*confZoneFile); err == nil {
+			prevSerial, prevRecords, err = readRPZZone(f)
+			f.Close()
+
+			if err != nil {
+				logger.Error.Printf("Can't read previous zone: %s\n", err.Error())
+
+				return 1
+			}
+		} else if !os.IsNotExist(err) {
+			logger.Error.Printf("Can't open previous zone: %s\n", err.Error())
+
+			return 1
+		}
+	}
+
+	CurrentDump = NewDump()
+
+	if err := parseDumpFile(*confDumpFile); err != nil {
+		logger.Error.Printf("Can't parse dump: %s\n", err.Error())
+
+		return 1
+	}
+
+	CurrentDump.RLock()
+	serial := uint32(CurrentDump.utime)
+	records := CurrentDump.rpzRecords(opts)
+	CurrentDump.RUnlock()
+
+	if err := writeFileWith(*confZoneFile, func(w io.Writer) error {
+		writeRPZZone(w, opts.Origin, serial, records)
+
+		return nil
+	}); err != nil {
+		logger.Error.Printf("Can't write zone: %s\n", err.Error())
+
+		return 1
+	}
+
+	if *confDiffFile != "" {
+		deleted, added := diffRPZ(prevRecords, records)
+
+		if err := writeFileWith(*confDiffFile, func(w io.Writer) error {
+			writeRPZDiff(w, opts.Origin, prevSerial, serial, deleted, added)
+
+			return nil
+		}); err != nil {
+			logger.Error.Printf("Can't write difference: %s\n", err.Error())
+
+			return 1
+		}
+
+		logger.Info.Printf("Serial %d -> %d, deleted: %d, added: %d\n", prevSerial, serial, len(deleted), len(added))
+	}
+
+	return 0
+}
+
+// writeFileWith - writes the file via temp file and rename, readers never see a partial file.
+// The file is left untouched if write fails.
+func writeFileWith(filename string, write func(w io.Writer) error) error {
+	tmpfilename := fmt.Sprintf("%s-temp", filename)
+
+	f, err := os.Create(tmpfilename)
+	if err != nil {
+		return fmt.Errorf("create tmpfile: %w", err)
+	}
+
+	w := bufio.NewWriter(f)
+
+	if err := write(w); err != nil {
+		f.Close()
+		os.Remove(tmpfilename)
+
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+
+		return fmt.Errorf("write: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close: %w", err)
+	}
+
+	if err := os.Rename(tmpfilename, filename); err != nil {
+		return fmt.Errorf("file rename: %w", err)
+	}
+
+	return nil
+}
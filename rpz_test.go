@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRPZ(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &rpzOptions{Origin: "rpz.local."}
+
+	if got := CurrentDump.rpzRecords(&rpzOptions{URLDomains: true}); len(got) != 2 || got[0].Owner != "www.e01.tld" {
+		t.Errorf("Expected URL domains, got %v", got)
+	}
+
+	records := CurrentDump.rpzRecords(opts)
+	serial := uint32(CurrentDump.utime)
+
+	var zone bytes.Buffer
+
+	writeRPZZone(&zone, opts.Origin, serial, records)
+
+	wantZone := "$ORIGIN rpz.local.\n$TTL 300\n@ SOA localhost. root.localhost. 1293832861 3600 600 86400 300\n@ NS localhost.\n" +
+		"www.e02.tld CNAME .\n"
+	if zone.String() != wantZone {
+		t.Errorf("Expected\n%s\ngot\n%s", wantZone, zone.String())
+	}
+
+	prevSerial, prevRecords, err := readRPZZone(&zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if prevSerial != serial || len(prevRecords) != 1 || prevRecords[0] != records[0] {
+		t.Errorf("Read zone error: %d %v", prevSerial, prevRecords)
+	}
+
+	masked := strings.NewReplacer(
+		`updateTime="2011-01-01T01:01:01+03:00"`, `updateTime="2011-01-02T01:01:01+03:00"`,
+		`blockType="domain" hash="PPPP"`, `blockType="domain-mask" hash="PPPP"`,
+		`org="FIVE"/>
+        <domain><![CDATA[www.e02.tld]]></domain>`, `org="FIVE"/>
+        <domain><![CDATA[*.e05.tld]]></domain>`,
+	).Replace(xml01)
+
+	if err := Parse(strings.NewReader(masked)); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, added := diffRPZ(prevRecords, CurrentDump.rpzRecords(opts))
+	if len(deleted) != 0 || len(added) != 2 || added[0].Owner != "*.e05.tld" || added[1].Owner != "e05.tld" {
+		t.Errorf("Diff error: deleted %v, added %v", deleted, added)
+	}
+
+	var diff bytes.Buffer
+
+	writeRPZDiff(&diff, opts.Origin, prevSerial, uint32(CurrentDump.utime), deleted, added[:1])
+
+	wantDiff := "$ORIGIN rpz.local.\n$TTL 300\n@ SOA localhost. root.localhost. 1293919261 3600 600 86400 300\n" +
+		"@ SOA localhost. root.localhost. 1293832861 3600 600 86400 300\n" +
+		"@ SOA localhost. root.localhost. 1293919261 3600 600 86400 300\n*.e05.tld CNAME .\n" +
+		"@ SOA localhost. root.localhost. 1293919261 3600 600 86400 300\n"
+	if diff.String() != wantDiff {
+		t.Errorf("Expected\n%s\ngot\n%s", wantDiff, diff.String())
+	}
+
+	redirect := &rpzOptions{Redirect: "10.0.0.1, fd00::1, block.example"}
+	if got := redirect.rpzAction("e05.tld"); len(got) != 3 || got[0].Type != "A" || got[1].Type != "AAAA" || got[2].Data != "block.example." {
+		t.Errorf("Redirect action error: %v", got)
+	}
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Match - content ID found by a search, why it was found and by which key.
+type Match struct {
+	ID      int32
+	Reason  pb.MatchReason
+	Key     string
+	Removed bool // it is a tombstone.
+}
+
+// matchSet - ordered list of matches, the first match of a content ID wins.
+type matchSet struct {
+	seen Int32Map
+	list []Match
+}
+
+func newMatchSet(size int) *matchSet {
+	return &matchSet{
+		seen: make(Int32Map, size),
+		list: make([]Match, 0, size),
+	}
+}
+
+// add - add all IDs with the same reason and key, skip already matched ones.
+func (s *matchSet) add(ids IntArrayStorage, reason pb.MatchReason, key string) {
+	for _, id := range ids {
+		if _, ok := s.seen[id]; ok {
+			continue
+		}
+
+		s.seen[id] = Nothing{}
+		s.list = append(s.list, Match{ID: id, Reason: reason, Key: key})
+	}
+}
+
+// All search methods below expect the dump to be read locked by the caller.
+
+// searchContentID - search by content ID.
+func (dump *Dump) searchContentID(id int32) []Match {
+	if _, ok := dump.ContentIndex[id]; !ok {
+		return nil
+	}
+
+	return []Match{{ID: id, Reason: pb.MatchReason_MATCH_CONTENT_ID, Key: strconv.Itoa(int(id))}}
+}
+
+// searchIPv4 - search by IPv4: exact address first, then containing subnets from the most specific one.
+func (dump *Dump) searchIPv4(ip4 uint32) []Match {
+	matches := newMatchSet(len(dump.IPv4Index[ip4]))
+
+	matches.add(dump.IPv4Index[ip4], pb.MatchReason_MATCH_IPV4, int2Ip4(ip4))
+
+	ipBytes := net.IPv4(byte(ip4>>24), byte(ip4>>16), byte(ip4>>8), byte(ip4))
+
+	cnw, err := dump.netTree.ContainingNetworks(ipBytes)
+	if err != nil {
+		logger.Debug.Printf("Can't get containing networks: %s: %s\n", ipBytes, err)
+
+		return matches.list
+	}
+
+	for i := len(cnw) - 1; i >= 0; i-- {
+		network := cnw[i].Network()
+		subnet := network.String()
+
+		matches.add(dump.subnetIPv4Index[subnet], pb.MatchReason_MATCH_IPV4_SUBNET, subnet)
+	}
+
+	return matches.list
+}
+
+// searchIPv6 - search by IPv6: exact address first, then containing subnets from the most specific one.
+func (dump *Dump) searchIPv6(ip6 net.IP) []Match {
+	matches := newMatchSet(len(dump.IPv6Index[string(ip6)]))
+
+	matches.add(dump.IPv6Index[string(ip6)], pb.MatchReason_MATCH_IPV6, ip6.String())
+
+	cnw, err := dump.netTree.ContainingNetworks(ip6)
+	if err != nil {
+		logger.Debug.Printf("Can't get containing networks: %s: %s\n", ip6, err)
+
+		return matches.list
+	}
+
+	for i := len(cnw) - 1; i >= 0; i-- {
+		network := cnw[i].Network()
+		subnet := network.String()
+
+		matches.add(dump.subnetIPv6Index[subnet], pb.MatchReason_MATCH_IPV6_SUBNET, subnet)
+	}
+
+	return matches.list
+}
+
+// searchURL - search by normalized URL.
+func (dump *Dump) searchURL(u string) []Match {
+	matches := newMatchSet(len(dump.URLIndex[u]))
+	matches.add(dump.URLIndex[u], pb.MatchReason_MATCH_URL, u)
+
+	return matches.list
+}
+
+// searchDomain - search by normalized domain.
+func (dump *Dump) searchDomain(domain string) []Match {
+	matches := newMatchSet(len(dump.domainIndex[domain]))
+	matches.add(dump.domainIndex[domain], pb.MatchReason_MATCH_DOMAIN, domain)
+
+	return matches.list
+}
+
+// searchDomainMask - search domain-mask records of the parent domains, the nearest parent first.
+func (dump *Dump) searchDomainMask(domain string) []Match {
+	matches := newMatchSet(0)
+
+	for parent := domain; ; {
+		_, rest, ok := strings.Cut(parent, ".")
+		if !ok || !strings.Contains(rest, ".") {
+			break
+		}
+
+		parent = rest
+
+		ids := make(IntArrayStorage, 0, len(dump.domainIndex[parent]))
+		for _, id := range dump.domainIndex[parent] {
+			if cont, ok := dump.ContentIndex[id]; ok && cont.BlockType == BlockTypeMask {
+				ids = append(ids, id)
+			}
+		}
+
+		matches.add(ids, pb.MatchReason_MATCH_DOMAIN_PARENT, parent)
+	}
+
+	return matches.list
+}
+
+// searchDomainSuffix - search by parent domain and, if variant is 2, by private public suffix.
+func (dump *Dump) searchDomainSuffix(domain string, variant int32) []Match {
+	parent, suffix := parentDomains(domain)
+	if parent == "" {
+		return nil
+	}
+
+	matches := newMatchSet(len(dump.publicSuffixIndex[parent]))
+	matches.add(dump.publicSuffixIndex[parent], pb.MatchReason_MATCH_DOMAIN_PARENT, parent)
+
+	if variant == 2 && suffix != "" {
+		matches.add(dump.publicSuffixIndex[suffix], pb.MatchReason_MATCH_DOMAIN_SUFFIX, suffix)
+	}
+
+	return matches.list
+}
+
+// searchDecision - search by decision hash.
+func (dump *Dump) searchDecision(decision uint64) []Match {
+	matches := newMatchSet(len(dump.decisionIndex[decision]))
+	matches.add(dump.decisionIndex[decision], pb.MatchReason_MATCH_DECISION, fmt.Sprintf("%d", decision))
+
+	return matches.list
+}
+
+// searchEntryType - search by entry type key.
+func (dump *Dump) searchEntryType(entryType string) []Match {
+	matches := newMatchSet(len(dump.entryTypeIndex[entryType]))
+	matches.add(dump.entryTypeIndex[entryType], pb.MatchReason_MATCH_ENTRY_TYPE, entryType)
+
+	return matches.list
+}
+
+// searchOrg - search by decision org.
+func (dump *Dump) searchOrg(org string) []Match {
+	matches := newMatchSet(len(dump.orgIndex[org]))
+	matches.add(dump.orgIndex[org], pb.MatchReason_MATCH_ORG, org)
+
+	return matches.list
+}
+
+// searchWithoutNo - search records with decision without number.
+func (dump *Dump) searchWithoutNo() []Match {
+	matches := newMatchSet(len(dump.withoutDecisionNo))
+	matches.add(dump.withoutDecisionNo, pb.MatchReason_MATCH_WITHOUT_DECISION_NO, "")
+
+	return matches.list
+}
+
+// searchTime - search records or elements by time, ordered by time.
+// Every timestamped element is a separate match.
+func (dump *Dump) searchTime(from, to int64, field pb.TimeField) []Match {
+	var (
+		index  TimeSearchIndex
+		reason pb.MatchReason
+	)
+
+	switch field {
+	case pb.TimeField_TIME_RECORD:
+		index, reason = dump.recordTimeIndex.Range(from, to), pb.MatchReason_MATCH_RECORD_TIME
+	case pb.TimeField_TIME_ELEMENT:
+		index, reason = dump.elementTimeIndex.Range(from, to), pb.MatchReason_MATCH_ELEMENT_TIME
+	default:
+		index, reason = dump.includeTimeIndex.Range(from, to), pb.MatchReason_MATCH_INCLUDE_TIME
+	}
+
+	matches := make([]Match, 0, len(index))
+
+	for _, entry := range index {
+		key := entry.Key
+		if key == "" {
+			key = strconv.FormatInt(entry.Ts, 10)
+		}
+
+		matches = append(matches, Match{ID: entry.ID, Reason: reason, Key: key})
+	}
+
+	return matches
+}
+
+// appendElementTimes - append timestamped elements of the record to the index.
+func (pack *PackedContent) appendElementTimes(index TimeSearchIndex) TimeSearchIndex {
+	for _, u := range pack.URL {
+		if u.Ts != 0 {
+			index = append(index, timeEntry{Ts: u.Ts, ID: pack.ID, Key: "url=" + u.URL})
+		}
+	}
+
+	for _, domain := range pack.Domain {
+		if domain.Ts != 0 {
+			index = append(index, timeEntry{Ts: domain.Ts, ID: pack.ID, Key: "domain=" + domain.Domain})
+		}
+	}
+
+	for _, ip4 := range pack.IPv4 {
+		if ip4.Ts != 0 {
+			index = append(index, timeEntry{Ts: ip4.Ts, ID: pack.ID, Key: "ip4=" + int2Ip4(ip4.IPv4)})
+		}
+	}
+
+	for _, ip6 := range pack.IPv6 {
+		if ip6.Ts != 0 {
+			index = append(index, timeEntry{Ts: ip6.Ts, ID: pack.ID, Key: "ip6=" + net.IP(ip6.IPv6).String()})
+		}
+	}
+
+	for _, subnet4 := range pack.SubnetIPv4 {
+		if subnet4.Ts != 0 {
+			index = append(index, timeEntry{Ts: subnet4.Ts, ID: pack.ID, Key: "subnet4=" + subnet4.SubnetIPv4})
+		}
+	}
+
+	for _, subnet6 := range pack.SubnetIPv6 {
+		if subnet6.Ts != 0 {
+			index = append(index, timeEntry{Ts: subnet6.Ts, ID: pack.ID, Key: "subnet6=" + subnet6.SubnetIPv6})
+		}
+	}
+
+	return index
+}
+
+// contents - convert matches to protobuf contents.
+func (dump *Dump) contents(matches []Match) []*pb.Content {
+	results := make([]*pb.Content, 0, len(matches))
+
+	for _, m := range matches {
+		cont, ok := dump.content(m)
+		if !ok {
+			continue
+		}
+
+		if m.Removed {
+			results = append(results, dump.tombstones.newPbTombstone(cont, m.Reason, m.Key))
+
+			continue
+		}
+
+		results = append(results, cont.newPbContent(m.Reason, m.Key))
+	}
+
+	return results
+}
+
+// searchResource - search the URL, host:port, domain or address, URLs are searched by the host too.
+// Domains match parent domain masks.
+func (dump *Dump) searchResource(u string) []Match {
+	r, err := lookup.ParseResource(u)
+	if err != nil {
+		return nil
+	}
+
+	matches := newMatchSet(0)
+
+	if r.URL != "" {
+		matches.add(dump.URLIndex[r.URL], pb.MatchReason_MATCH_URL, r.URL)
+	}
+
+	var found []Match
+
+	switch {
+	case r.IP == nil:
+		found = append(dump.searchDomain(r.Domain), dump.searchDomainMask(r.Domain)...)
+	case len(r.IP) == net.IPv4len:
+		found = dump.searchIPv4(IPv4StrToInt(r.IP.String()))
+	default:
+		found = dump.searchIPv6(r.IP)
+	}
+
+	for _, m := range found {
+		matches.add(IntArrayStorage{m.ID}, m.Reason, m.Key)
+	}
+
+	return matches.list
+}
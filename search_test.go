@@ -0,0 +1,150 @@
+package main
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestSearchMatchReason(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		matches func() []Match
+		want    []Match
+	}{
+		{
+			name:    "IPv4 exact and subnet deduplicated",
+			matches: func() []Match { return CurrentDump.searchIPv4(IPv4StrToInt("10.4.4.4")) },
+			want:    []Match{{ID: 444, Reason: pb.MatchReason_MATCH_IPV4, Key: "10.4.4.4"}},
+		},
+		{
+			name:    "IPv4 subnet",
+			matches: func() []Match { return CurrentDump.searchIPv4(IPv4StrToInt("10.4.1.1")) },
+			want:    []Match{{ID: 444, Reason: pb.MatchReason_MATCH_IPV4_SUBNET, Key: "10.4.0.0/16"}},
+		},
+		{
+			name:    "IPv6 exact",
+			matches: func() []Match { return CurrentDump.searchIPv6(net.ParseIP("fd11:1::1")) },
+			want:    []Match{{ID: 111, Reason: pb.MatchReason_MATCH_IPV6, Key: "fd11:1::1"}},
+		},
+		{
+			name:    "domain",
+			matches: func() []Match { return CurrentDump.searchDomain("www.e02.tld") },
+			want: []Match{
+				{ID: 222, Reason: pb.MatchReason_MATCH_DOMAIN, Key: "www.e02.tld"},
+				{ID: 555, Reason: pb.MatchReason_MATCH_DOMAIN, Key: "www.e02.tld"},
+			},
+		},
+		{
+			name:    "URL",
+			matches: func() []Match { return CurrentDump.searchURL("http://www.e01.tld/slip") },
+			want:    []Match{{ID: 111, Reason: pb.MatchReason_MATCH_URL, Key: "http://www.e01.tld/slip"}},
+		},
+		{
+			name:    "content ID",
+			matches: func() []Match { return CurrentDump.searchContentID(333) },
+			want:    []Match{{ID: 333, Reason: pb.MatchReason_MATCH_CONTENT_ID, Key: "333"}},
+		},
+		{
+			name:    "nothing",
+			matches: func() []Match { return CurrentDump.searchIPv4(IPv4StrToInt("1.1.1.1")) },
+			want:    []Match{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.matches()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Expected %v, got %v", tt.want[i], got[i])
+				}
+			}
+		})
+	}
+
+	results := CurrentDump.contents(CurrentDump.searchIPv4(IPv4StrToInt("10.4.4.4")))
+	if len(results) != 1 || results[0].Ip4 != IPv4StrToInt("10.4.4.4") || results[0].MatchKey != "10.4.4.4" {
+		t.Errorf("Legacy fields error: %v", results)
+	}
+}
+
+const xmlTimes string = `<?xml version="1.0" encoding="windows-1251"?>
+<reg:register xmlns:reg="http://rsoc.ru" xmlns:tns="http://rsoc.ru" updateTime="2024-01-10T10:00:00+03:00" updateTimeUrgently="2024-01-10T10:00:00+03:00" formatVersion="2.4">
+<content id="1" includeTime="2024-01-01T10:00:00" entryType="1" blockType="ip" hash="AAAA" ts="2024-01-09T10:00:00+03:00">
+        <decision date="2024-01-01" number="1" org="ONE"/>
+        <ip ts="2024-01-01T10:00:00+03:00">10.0.0.1</ip>
+        <ip ts="2024-01-09T12:00:00+03:00">10.0.0.2</ip>
+</content>
+<content id="2" includeTime="2024-01-05T10:00:00" entryType="1" blockType="domain" hash="BBBB">
+        <decision date="2024-01-05" number="2" org="TWO"/>
+        <domain ts="2024-01-05T10:00:00+03:00"><![CDATA[example.com]]></domain>
+</content>
+</reg:register>`
+
+func TestSearchTime(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xmlTimes)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		from  string
+		to    string
+		field pb.TimeField
+		want  []Match
+	}{
+		{
+			name:  "include time",
+			from:  "2024-01-02T00:00:00+03:00",
+			field: pb.TimeField_TIME_INCLUDE,
+			want:  []Match{{ID: 2, Reason: pb.MatchReason_MATCH_INCLUDE_TIME, Key: "1704438000"}},
+		},
+		{
+			name:  "record time",
+			from:  "2024-01-01T00:00:00+03:00",
+			to:    "2024-01-10T00:00:00+03:00",
+			field: pb.TimeField_TIME_RECORD,
+			want:  []Match{{ID: 1, Reason: pb.MatchReason_MATCH_RECORD_TIME, Key: "1704783600"}},
+		},
+		{
+			name:  "elements",
+			from:  "2024-01-05T00:00:00+03:00",
+			to:    "2024-01-10T00:00:00+03:00",
+			field: pb.TimeField_TIME_ELEMENT,
+			want: []Match{
+				{ID: 2, Reason: pb.MatchReason_MATCH_ELEMENT_TIME, Key: "domain=example.com"},
+				{ID: 1, Reason: pb.MatchReason_MATCH_ELEMENT_TIME, Key: "ip4=10.0.0.2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CurrentDump.searchTime(parseRFC3339Time(tt.from), parseRFC3339Time(tt.to), tt.field)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Expected %v, got %v", tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
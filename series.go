@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrUnknownMetric = errors.New("unknown metric")
+	ErrNeedMetricKey = errors.New("metric needs key")
+)
+
+// StatSeries - summaries of all applied dumps.
+var StatSeries = NewSeries("")
+
+// Series metrics with keys.
+const (
+	metricEntryTypes   = "entry_types"
+	metricDecisionOrgs = "decision_orgs"
+)
+
+// seriesMetrics - summary metrics by JSON field name.
+var seriesMetrics = map[string]func(v *SummaryValues) int{
+	"content_entries":             func(v *SummaryValues) int { return v.ContentEntries },
+	"ipv4_entries":                func(v *SummaryValues) int { return v.IPv4Entries },
+	"ipv6_entries":                func(v *SummaryValues) int { return v.IPv6Entries },
+	"domain_entries":              func(v *SummaryValues) int { return v.DomainEntries },
+	"url_entries":                 func(v *SummaryValues) int { return v.URLEntries },
+	"subnet_ipv4_entries":         func(v *SummaryValues) int { return v.SubnetIPv4Entries },
+	"subnet_ipv6_entries":         func(v *SummaryValues) int { return v.SubnetIPv6Entries },
+	"block_type_url":              func(v *SummaryValues) int { return v.BlockTypeURL },
+	"block_type_https":            func(v *SummaryValues) int { return v.BlockTypeHTTPS },
+	"block_type_domain":           func(v *SummaryValues) int { return v.BlockTypeDomain },
+	"block_type_mask":             func(v *SummaryValues) int { return v.BlockTypeMask },
+	"block_type_ip":               func(v *SummaryValues) int { return v.BlockTypeIP },
+	"largest_size_of_content":     func(v *SummaryValues) int { return v.LargestSizeOfContent },
+	"max_item_references":         func(v *SummaryValues) int { return v.MaxItemReferences },
+	"entries_without_decision_no": func(v *SummaryValues) int { return v.EntriesWithoutDecisionNo },
+	"count":                       func(v *SummaryValues) int { return v.Count },
+	"added":                       func(v *SummaryValues) int { return v.Added },
+	"updated":                     func(v *SummaryValues) int { return v.Updated },
+	"removed":                     func(v *SummaryValues) int { return v.Removed },
+}
+
+// summedMetrics - per dump changes, they are summed in the bucket.
+var summedMetrics = map[string]bool{"added": true, "updated": true, "removed": true}
+
+// Series - summaries of applied dumps ordered by update time, optionally persisted as JSON lines.
+type Series struct {
+	sync.RWMutex
+	Points   []*SummaryValues
+	filename string
+}
+
+func NewSeries(filename string) *Series {
+	return &Series{filename: filename}
+}
+
+// LoadSeries - read the series file, missing file is an empty series.
+func LoadSeries(filename string) (*Series, error) {
+	s := NewSeries(filename)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+
+		return nil, fmt.Errorf("open series: %w", err)
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<24)
+
+	for scanner.Scan() {
+		v := &SummaryValues{}
+		if err := json.Unmarshal(scanner.Bytes(), v); err != nil {
+			return nil, fmt.Errorf("decode series: %w", err)
+		}
+
+		s.add(v)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read series: %w", err)
+	}
+
+	return s, nil
+}
+
+// add - add the summary in time order, the summary of the same dump replaces the previous one.
+func (s *Series) add(v *SummaryValues) bool {
+	if n := len(s.Points); n > 0 {
+		last := s.Points[n-1]
+
+		switch {
+		case v.UpdateTime < last.UpdateTime:
+			return false
+		case v.UpdateTime == last.UpdateTime:
+			s.Points[n-1] = v
+
+			return true
+		}
+	}
+
+	s.Points = append(s.Points, v)
+
+	return true
+}
+
+// Add - add the summary of the applied dump and append it to the series file.
+func (s *Series) Add(v *SummaryValues) {
+	s.Lock()
+	defer s.Unlock()
+
+	// the same dump parsed again keeps changes of the first parse.
+	if n := len(s.Points); n > 0 && s.Points[n-1].UpdateTime == v.UpdateTime {
+		last, merged := s.Points[n-1], *v
+		merged.Added += last.Added
+		merged.Updated += last.Updated
+		merged.Removed += last.Removed
+		v = &merged
+	}
+
+	if !s.add(v) {
+		logger.Warning.Printf("Dump is older than the series, not recorded: %d\n", v.UpdateTime)
+
+		return
+	}
+
+	if err := s.append(v); err != nil {
+		logger.Error.Printf("Can't save series: %s\n", err.Error())
+	}
+}
+
+// append - append the summary to the series file.
+func (s *Series) append(v *SummaryValues) error {
+	if s.filename == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	f, err := os.OpenFile(s.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+
+		return fmt.Errorf("write: %w", err)
+	}
+
+	return f.Close()
+}
+
+// seriesMetric - metric value getter.
+func seriesMetric(metric, key string) (func(v *SummaryValues) int, error) {
+	switch metric {
+	case metricEntryTypes, metricDecisionOrgs:
+		if key == "" {
+			return nil, fmt.Errorf("%w: %s", ErrNeedMetricKey, metric)
+		}
+
+		if metric == metricEntryTypes {
+			return func(v *SummaryValues) int { return v.EntryTypes[key] }, nil
+		}
+
+		return func(v *SummaryValues) int { return v.DecisionOrgs[key] }, nil
+	}
+
+	if fn, ok := seriesMetrics[metric]; ok {
+		return fn, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownMetric, metric)
+}
+
+// bucketStart - start of the Moscow day or hour of t.
+func bucketStart(t int64, resolution pb.Resolution) int64 {
+	tm := time.Unix(t, 0).In(locationMSK)
+
+	if resolution == pb.Resolution_RESOLUTION_HOURLY {
+		return time.Date(tm.Year(), tm.Month(), tm.Day(), tm.Hour(), 0, 0, 0, locationMSK).Unix()
+	}
+
+	return time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, locationMSK).Unix()
+}
+
+// Query - metric points bucketed by the resolution.
+func (s *Series) Query(metric, key string, r *pb.TimeRange, resolution pb.Resolution) ([]*pb.SeriesPoint, error) {
+	value, err := seriesMetric(metric, key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	start := sort.Search(len(s.Points), func(i int) bool { return s.Points[i].UpdateTime >= r.GetFrom() })
+
+	var points []*pb.SeriesPoint
+
+	for _, v := range s.Points[start:] {
+		if !inTimeRange(r, v.UpdateTime) {
+			break
+		}
+
+		bucket := bucketStart(v.UpdateTime, resolution)
+
+		if len(points) == 0 || points[len(points)-1].Time != bucket {
+			points = append(points, &pb.SeriesPoint{Time: bucket})
+		}
+
+		point := points[len(points)-1]
+		point.UpdateTime = v.UpdateTime
+		point.Dumps++
+
+		if summedMetrics[metric] {
+			point.Value += int64(value(v))
+		} else {
+			point.Value = int64(value(v))
+		}
+	}
+
+	return points, nil
+}
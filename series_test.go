@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestSeries(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "series")
+
+	CurrentDump = NewDump()
+	StatSeries = NewSeries(filename)
+
+	defer func() { StatSeries = NewSeries("") }()
+
+	for _, dump := range []string{
+		xml01,
+		dumpWithout("2011-01-01T05:01:01+03:00", "555"),
+		dumpWithout("2011-01-02T01:01:01+03:00", "444"),
+		dumpWithout("2011-01-02T01:01:01+03:00", "444"), // the same dump again.
+	} {
+		if err := Parse(strings.NewReader(dump)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	series, err := LoadSeries(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(series.Points) != 3 {
+		t.Fatalf("Expected 3 points, got %d", len(series.Points))
+	}
+
+	day := parseRFC3339Time("2011-01-01T00:00:00+03:00")
+
+	tests := []struct {
+		name       string
+		metric     string
+		key        string
+		resolution pb.Resolution
+		want       [][2]int64 // bucket, value
+		err        error
+	}{
+		{"daily gauge", "content_entries", "", pb.Resolution_RESOLUTION_DAILY, [][2]int64{{day, 4}, {day + 86400, 4}}, nil},
+		{"daily summed", "removed", "", pb.Resolution_RESOLUTION_DAILY, [][2]int64{{day, 1}, {day + 86400, 1}}, nil},
+		{"hourly", "added", "", pb.Resolution_RESOLUTION_HOURLY, [][2]int64{{day + 3600, 5}, {day + 5*3600, 0}, {day + 86400 + 3600, 1}}, nil},
+		{"org", "decision_orgs", "FOUR", pb.Resolution_RESOLUTION_DAILY, [][2]int64{{day, 1}, {day + 86400, 0}}, nil},
+		{"no key", "entry_types", "", pb.Resolution_RESOLUTION_DAILY, nil, ErrNeedMetricKey},
+		{"unknown", "nothing", "", pb.Resolution_RESOLUTION_DAILY, nil, ErrUnknownMetric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points, err := series.Query(tt.metric, tt.key, &pb.TimeRange{}, tt.resolution)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected error %v, got %v", tt.err, err)
+			}
+
+			if len(points) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, points)
+			}
+
+			for i, p := range points {
+				if p.Time != tt.want[i][0] || p.Value != tt.want[i][1] {
+					t.Errorf("Expected %v, got %v", tt.want, points)
+				}
+			}
+		})
+	}
+
+	points, _ := series.Query("count", "", &pb.TimeRange{From: day + 86400}, pb.Resolution_RESOLUTION_DAILY)
+	if len(points) != 1 || points[0].Value != 4 {
+		t.Errorf("Expected one point from the second day, got %v", points)
+	}
+}
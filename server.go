@@ -11,7 +11,10 @@ import (
 	"hash/fnv"
 	"net"
 
+	"google.golang.org/protobuf/encoding/prototext"
+
 	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
 	pb "github.com/usher2/u2ckdump/msg"
 )
 
@@ -29,9 +32,7 @@ func String2fnv2base32(s string) string {
 }
 
 func String2fnv2uint64(s string) uint64 {
-	h64 := fnv.New64a()
-	h64.Write([]byte(s))
-	return h64.Sum64()
+	return lookup.Hash(s)
 }
 
 func Uint64ToBase32(i uint64) string {
@@ -50,32 +51,54 @@ func Base32ToUint64(s string) (uint64, error) {
 	return 0, err
 }
 
-// SearchDecision - search by decision number.
-func (s *server) SearchDecision(ctx context.Context, in *pb.DecisionRequest) (*pb.SearchResponse, error) {
-	query := in.GetQuery()
+// searchRequest - common options of search requests.
+type searchRequest interface {
+	GetPage() *pb.Page
+	GetIncludeRemoved() bool
+	GetAsOf() int64
+}
 
-	logger.Debug.Printf("Received decision: %d\n", query)
+// withDump - runs fn with the read locked current dump or with the history snapshot at asOf.
+func withDump(asOf int64, fn func(dump *Dump) *pb.SearchResponse) *pb.SearchResponse {
+	if asOf != 0 {
+		dump, err := CurrentDump.history.Snapshot(asOf)
+		if err != nil {
+			return &pb.SearchResponse{Error: err.Error()}
+		}
+
+		return fn(dump)
+	}
 
 	// TODO: Change to DunpSnap search method.
 	if CurrentDump != nil && CurrentDump.utime > 0 {
 		CurrentDump.RLock()
+		defer CurrentDump.RUnlock()
 
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: fmt.Sprintf("%d", query)}
-		results := CurrentDump.decisionIndex[query]
-		resp.Results = make([]*pb.Content, 0, len(results))
+		return fn(CurrentDump)
+	}
 
-		for _, id := range results {
-			if v, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, v.newPbContent(0, nil, "", "", ""))
-			}
-		}
+	return &pb.SearchResponse{Error: SrvDataNotReady}
+}
 
-		CurrentDump.RUnlock()
+// respond - runs the search and fills the paginated response.
+func respond(in searchRequest, query string, search func(dump *Dump) []Match) *pb.SearchResponse {
+	return withDump(in.GetAsOf(), func(dump *Dump) *pb.SearchResponse {
+		resp := &pb.SearchResponse{RegistryUpdateTime: dump.utime, Query: query}
+		dump.fillResponse(resp, dump.search(in.GetIncludeRemoved(), search), in.GetPage(), in.GetIncludeRemoved())
 
-		return resp, nil
-	}
+		return resp
+	})
+}
 
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+// SearchDecision - search by decision number.
+func (s *server) SearchDecision(ctx context.Context, in *pb.DecisionRequest) (*pb.SearchResponse, error) {
+	query := in.GetQuery()
+
+	logger.Debug.Printf("Received decision: %d\n", query)
+
+	return respond(in, fmt.Sprintf("%d", query), func(dump *Dump) []Match {
+		return dump.searchDecision(query)
+	}), nil
 }
 
 // SearchContentID - search by content ID.
@@ -84,89 +107,20 @@ func (s *server) SearchContentID(ctx context.Context, in *pb.ContentIDRequest) (
 
 	logger.Debug.Printf("Received content ID: %d\n", query)
 
-	// TODO: Change to DunpSnap search method.
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: fmt.Sprintf("%d", query)}
-
-		if result, ok := CurrentDump.ContentIndex[query]; ok {
-			resp.Results = append(resp.Results, result.newPbContent(0, nil, "", "", ""))
-		}
-
-		CurrentDump.RUnlock()
-
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, fmt.Sprintf("%d", query), func(dump *Dump) []Match {
+		return dump.searchContentID(query)
+	}), nil
 }
 
 // SearchIPv4 - search by IPv4.
 func (s *server) SearchIPv4(c context.Context, in *pb.IPv4Request) (*pb.SearchResponse, error) {
 	query := in.GetQuery()
-	ipBytes := net.IP{
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff,
-		byte((query & 0xFF000000) >> 24),
-		byte((query & 0x00FF0000) >> 16),
-		byte((query & 0x0000FF00) >> 8),
-		byte(query & 0x000000FF),
-	}
-
-	logger.Debug.Printf("Received IPv4: %s\n", ipBytes)
-
-	var resultSubnets, resulIPs IntArrayStorage
-	var subnets []string
 
-	// TODO: Change to DunpSnap search method.
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
+	logger.Debug.Printf("Received IPv4: %s\n", int2Ip4(query))
 
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: ipBytes.String()}
-
-		// TODO: Change to DumpSnap search method
-		cnw, err := CurrentDump.netTree.ContainingNetworks(ipBytes)
-		if err != nil {
-			logger.Debug.Printf("Can't get containing networks: %s: %s\n", ipBytes, err)
-		} else {
-			for _, entry := range cnw {
-				subnet := entry.Network()
-				subnetStr := subnet.String()
-
-				if a, ok := CurrentDump.subnetIPv4Index[subnetStr]; ok {
-					resultSubnets = append(resultSubnets, a...)
-
-					for range a {
-						subnets = append(subnets, subnetStr)
-					}
-				}
-			}
-		}
-
-		if a, ok := CurrentDump.IPv4Index[query]; ok {
-			resulIPs = append(resulIPs, a...)
-		}
-
-		resp.Results = make([]*pb.Content, 0, len(resultSubnets)+len(resulIPs))
-
-		for i, id := range resultSubnets {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, "", "", subnets[i]))
-			}
-		}
-
-		for _, id := range resulIPs {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(query, nil, "", "", ""))
-			}
-		}
-
-		CurrentDump.RUnlock()
-
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, int2Ip4(query), func(dump *Dump) []Match {
+		return dump.searchIPv4(query)
+	}), nil
 }
 
 // SearchID - search by IPv6.
@@ -177,26 +131,9 @@ func (s *server) SearchIPv6(ctx context.Context, in *pb.IPv6Request) (*pb.Search
 
 	logger.Debug.Printf("Received IPv6: %s\n", ip.String())
 
-	// TODO: Change to DunpSnap search method.
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: ip.String()}
-		results := CurrentDump.IPv6Index[string(query)]
-		resp.Results = make([]*pb.Content, 0, len(results))
-
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, query, "", "", ""))
-			}
-		}
-
-		CurrentDump.RUnlock()
-
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, ip.String(), func(dump *Dump) []Match {
+		return dump.searchIPv6(ip)
+	}), nil
 }
 
 // SearchID - search by URL.
@@ -205,26 +142,9 @@ func (s *server) SearchURL(ctx context.Context, in *pb.URLRequest) (*pb.SearchRe
 
 	logger.Debug.Printf("Received URL: %v\n", query)
 
-	// TODO: Change to DunpSnap search method.
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: query}
-		results := CurrentDump.URLIndex[query]
-		resp.Results = make([]*pb.Content, 0, len(results))
-
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, "", query, ""))
-			}
-		}
-
-		CurrentDump.RUnlock()
-
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, query, func(dump *Dump) []Match {
+		return dump.searchURL(query)
+	}), nil
 }
 
 // SearchDomain - search by domain.
@@ -233,26 +153,9 @@ func (s *server) SearchDomain(ctx context.Context, in *pb.DomainRequest) (*pb.Se
 
 	logger.Debug.Printf("Received Domain: %v\n", query)
 
-	// TODO: Change to DunpSnap search method.
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: query}
-		results := CurrentDump.domainIndex[query]
-		resp.Results = make([]*pb.Content, 0, len(results))
-
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, query, "", ""))
-			}
-		}
-
-		CurrentDump.RUnlock()
-
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, query, func(dump *Dump) []Match {
+		return dump.searchDomain(query)
+	}), nil
 }
 
 // SearchSuffix - search by domain public suffix.
@@ -262,148 +165,137 @@ func (s *server) SearchDomainSuffix(ctx context.Context, in *pb.SuffixRequest) (
 
 	logger.Debug.Printf("Received Domain Suffix: %v\n", query)
 
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-		defer CurrentDump.RUnlock()
-
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: query}
-
-		parent, suffix := parentDomains(query)
-		if parent == "" && suffix == "" {
-			resp.Results = make([]*pb.Content, 0)
-
-			return resp, nil
-		}
-
-		logger.Debug.Printf("***Suffixes: %s, %s\n", parent, suffix)
-
-		if parent == "" {
-			resp.Results = make([]*pb.Content, 0)
+	return respond(in, query, func(dump *Dump) []Match {
+		return dump.searchDomainSuffix(query, variant)
+	}), nil
+}
 
-			return resp, nil
-		}
+// SearchEntryType - search by entry type.
+func (s *server) SearchEntryType(ctx context.Context, in *pb.EntryTypeRequest) (*pb.SearchResponse, error) {
+	query := in.GetQuery()
 
-		results := CurrentDump.publicSuffixIndex[parent]
+	logger.Debug.Printf("Received EntryType: %v\n", query)
 
-		logger.Debug.Printf("***Parent: %s, results: %v\n", parent, results)
+	return respond(in, query, func(dump *Dump) []Match {
+		return dump.searchEntryType(query)
+	}), nil
+}
 
-		resp.Results = make([]*pb.Content, 0, len(results))
+func (s *server) SearchOrg(ctx context.Context, in *pb.OrgRequest) (*pb.SearchResponse, error) {
+	query := in.GetQuery()
 
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, parent, "", ""))
-			}
-		}
+	logger.Debug.Printf("Received Org: %x\n", query)
 
-		if variant != 2 || suffix == "" {
-			return resp, nil
-		}
+	return withDump(in.GetAsOf(), func(dump *Dump) *pb.SearchResponse {
+		orgForSearch := dump.packedOrgIndex[query]
 
-		results = CurrentDump.publicSuffixIndex[suffix]
+		resp := &pb.SearchResponse{RegistryUpdateTime: dump.utime, Query: orgForSearch}
+		matches := dump.search(in.GetIncludeRemoved(), func(dump *Dump) []Match {
+			return dump.searchOrg(orgForSearch)
+		})
+		dump.fillResponse(resp, matches, in.GetPage(), in.GetIncludeRemoved())
 
-		logger.Debug.Printf("***Suffix: %s, results: %v\n", suffix, results)
+		return resp
+	}), nil
+}
 
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, suffix, "", ""))
-			}
-		}
+func (s *server) SearchWithoutNo(ctx context.Context, in *pb.WithoutNoRequest) (*pb.SearchResponse, error) {
+	query := in.GetQuery()
 
-		return resp, nil
-	}
+	logger.Debug.Printf("Received WithoutNo: %v\n", query)
 
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, "", func(dump *Dump) []Match {
+		return dump.searchWithoutNo()
+	}), nil
 }
 
-// SearchEntryType - search by entry type.
-func (s *server) SearchEntryType(ctx context.Context, in *pb.EntryTypeRequest) (*pb.SearchResponse, error) {
-	query := in.GetQuery()
+// Query - faceted multi-criteria search.
+func (s *server) Query(ctx context.Context, in *pb.QueryRequest) (*pb.SearchResponse, error) {
+	query := prototext.Format(&pb.QueryRequest{Clauses: in.GetClauses()})
 
-	logger.Debug.Printf("Received EntryType: %v\n", query)
+	logger.Debug.Printf("Received Query: %s\n", query)
 
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-		defer CurrentDump.RUnlock()
+	return withDump(in.GetAsOf(), func(dump *Dump) *pb.SearchResponse {
+		resp := &pb.SearchResponse{RegistryUpdateTime: dump.utime, Query: query}
 
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: query}
+		matches := dump.search(in.GetIncludeRemoved(), func(dump *Dump) []Match {
+			return dump.searchQuery(in.GetClauses())
+		})
+		resp.Facets = dump.facets(matches)
+		dump.fillResponse(resp, matches, in.GetPage(), in.GetIncludeRemoved())
 
-		results, ok := CurrentDump.entryTypeIndex[query]
-		if !ok {
-			resp.Results = make([]*pb.Content, 0)
+		return resp
+	}), nil
+}
 
-			return resp, nil
-		}
+// SearchTime - search records or elements by time.
+func (s *server) SearchTime(ctx context.Context, in *pb.TimeRequest) (*pb.SearchResponse, error) {
+	from, to := in.GetQuery().GetFrom(), in.GetQuery().GetTo()
+	field := in.GetField()
 
-		resp.Results = make([]*pb.Content, 0, len(results))
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, "", "", ""))
-			}
-		}
+	logger.Debug.Printf("Received Time: %s [%d, %d)\n", field, from, to)
 
-		return resp, nil
-	}
-
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return respond(in, fmt.Sprintf("%s [%d, %d)", field, from, to), func(dump *Dump) []Match {
+		return dump.searchTime(from, to, field)
+	}), nil
 }
 
-func (s *server) SearchOrg(ctx context.Context, in *pb.OrgRequest) (*pb.SearchResponse, error) {
+// RecordHistory - versions of the record with field level diffs.
+func (s *server) RecordHistory(ctx context.Context, in *pb.RecordHistoryRequest) (*pb.RecordHistoryResponse, error) {
 	query := in.GetQuery()
 
-	logger.Debug.Printf("Received Org: %x\n", query)
-
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-		defer CurrentDump.RUnlock()
-
-		orgForSearch := CurrentDump.packedOrgIndex[query]
+	logger.Debug.Printf("Received record history: %d\n", query)
 
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime, Query: orgForSearch}
+	if CurrentDump == nil || CurrentDump.utime == 0 {
+		return &pb.RecordHistoryResponse{Error: SrvDataNotReady}, nil
+	}
 
-		results, ok := CurrentDump.orgIndex[orgForSearch]
-		if !ok {
-			resp.Results = make([]*pb.Content, 0)
+	versions, err := CurrentDump.history.RecordVersions(query)
+	if err != nil {
+		return &pb.RecordHistoryResponse{Error: err.Error()}, nil
+	}
 
-			return resp, nil
-		}
+	return &pb.RecordHistoryResponse{Query: query, Versions: versions}, nil
+}
 
-		resp.Results = make([]*pb.Content, 0, len(results))
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, "", "", ""))
-			}
-		}
+// SummarySeries - summary metric by time.
+func (s *server) SummarySeries(ctx context.Context, in *pb.SeriesRequest) (*pb.SeriesResponse, error) {
+	logger.Debug.Printf("Received summary series: %s %s\n", in.GetMetric(), in.GetKey())
 
-		return resp, nil
+	points, err := StatSeries.Query(in.GetMetric(), in.GetKey(), in.GetRange(), in.GetResolution())
+	if err != nil {
+		return &pb.SeriesResponse{Error: err.Error()}, nil
 	}
 
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	return &pb.SeriesResponse{Points: points}, nil
 }
 
-func (s *server) SearchWithoutNo(ctx context.Context, in *pb.WithoutNoRequest) (*pb.SearchResponse, error) {
-	query := in.GetQuery()
-
-	logger.Debug.Printf("Received WithoutNo: %v\n", query)
+// Lint - repaired and quarantined elements of the current dump.
+func (s *server) Lint(ctx context.Context, in *pb.LintRequest) (*pb.LintResponse, error) {
+	logger.Debug.Printf("Received lint: %v\n", in.GetInvalidOnly())
 
-	if CurrentDump != nil && CurrentDump.utime > 0 {
-		CurrentDump.RLock()
-		defer CurrentDump.RUnlock()
+	if CurrentDump == nil || CurrentDump.utime == 0 {
+		return &pb.LintResponse{Error: SrvDataNotReady}, nil
+	}
 
-		resp := &pb.SearchResponse{RegistryUpdateTime: CurrentDump.utime}
+	CurrentDump.RLock()
+	defer CurrentDump.RUnlock()
 
-		results := CurrentDump.withoutDecisionNo
+	return CurrentDump.lintReport(in.GetInvalidOnly()), nil
+}
 
-		resp.Results = make([]*pb.Content, 0, len(results))
-		for _, id := range results {
-			if cont, ok := CurrentDump.ContentIndex[id]; ok {
-				resp.Results = append(resp.Results, cont.newPbContent(0, nil, "", "", ""))
-			}
-		}
+// Consistency - structural check findings of the current dump.
+func (s *server) Consistency(ctx context.Context, in *pb.ConsistencyRequest) (*pb.ConsistencyResponse, error) {
+	logger.Debug.Printf("Received consistency: %v %d\n", in.GetChecks(), in.GetId())
 
-		return resp, nil
+	if CurrentDump == nil || CurrentDump.utime == 0 {
+		return &pb.ConsistencyResponse{Error: SrvDataNotReady}, nil
 	}
 
-	return &pb.SearchResponse{Error: SrvDataNotReady}, nil
+	CurrentDump.RLock()
+	defer CurrentDump.RUnlock()
+
+	return CurrentDump.consistencyReport(in.GetChecks(), in.GetId()), nil
 }
 
 // Ping - just ping.
@@ -0,0 +1,40 @@
+package main
+
+import "sort"
+
+// timeEntry - timestamped reference to a record or its element.
+type timeEntry struct {
+	Ts  int64
+	ID  int32
+	Key string
+}
+
+// TimeSearchIndex - time sorted array of references.
+type TimeSearchIndex []timeEntry
+
+// Sort - sort the index by time, then by ID.
+func (a TimeSearchIndex) Sort() {
+	sort.Slice(a, func(i, j int) bool {
+		if a[i].Ts != a[j].Ts {
+			return a[i].Ts < a[j].Ts
+		}
+
+		return a[i].ID < a[j].ID
+	})
+}
+
+// Range - references in [from, to), zero is unbounded.
+func (a TimeSearchIndex) Range(from, to int64) TimeSearchIndex {
+	start := sort.Search(len(a), func(i int) bool { return a[i].Ts >= from })
+	end := len(a)
+
+	if to != 0 {
+		end = sort.Search(len(a), func(i int) bool { return a[i].Ts >= to })
+	}
+
+	if start >= end {
+		return nil
+	}
+
+	return a[start:end]
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// squidHelper - Squid external_acl_type helper checking requests with the Check service.
+// OK means the request is blocked, the first content ID is the tag, all IDs are in the ids note.
+type squidHelper struct {
+	client  pb.CheckClient
+	timeout time.Duration
+
+	mu sync.Mutex // serializes responses.
+	w  *bufio.Writer
+}
+
+// squidMain - squid command: external ACL helper on stdin/stdout.
+//
+//	external_acl_type u2ck concurrency=50 ttl=60 %URI /usr/local/bin/u2ckdump squid -s 127.0.0.1:50001
+//	acl blocked external u2ck
+//	http_access deny blocked
+func squidMain(args []string) int {
+	fs := flag.NewFlagSet("squid", flag.ExitOnError)
+	confServer := fs.String("s", "127.0.0.1:50001", "Check service address")
+	confTimeout := fs.Duration("t", 2*time.Second, "Check timeout")
+	confLogLevel := fs.String("l", "Error", "Logging level, logs go to stderr")
+	fs.Parse(args)
+
+	// stdout is the helper channel.
+	switch *confLogLevel {
+	case "Debug":
+		logger.LogInit(os.Stderr, os.Stderr, os.Stderr, os.Stderr)
+	case "Info":
+		logger.LogInit(io.Discard, os.Stderr, os.Stderr, os.Stderr)
+	default:
+		logInit(*confLogLevel)
+	}
+
+	conn, err := grpc.NewClient(*confServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error.Printf("Can't connect: %s\n", err.Error())
+
+		return 1
+	}
+
+	defer conn.Close()
+
+	helper := &squidHelper{client: pb.NewCheckClient(conn), timeout: *confTimeout}
+
+	if err := helper.serve(os.Stdin, os.Stdout); err != nil {
+		logger.Error.Printf("Helper error: %s\n", err.Error())
+
+		return 1
+	}
+
+	return 0
+}
+
+// serve - reads requests till EOF. Requests with channel IDs are checked concurrently.
+func (h *squidHelper) serve(r io.Reader, w io.Writer) error {
+	h.w = bufio.NewWriter(w)
+
+	var wg sync.WaitGroup
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		channel, tokens := parseSquidRequest(scanner.Text())
+
+		if channel == "" {
+			h.reply(channel, h.check(tokens))
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			h.reply(channel, h.check(tokens))
+		}()
+	}
+
+	wg.Wait()
+
+	return scanner.Err()
+}
+
+// parseSquidRequest - optional numeric channel ID and the request tokens.
+func parseSquidRequest(line string) (string, []string) {
+	tokens := strings.Fields(line)
+
+	if len(tokens) > 1 {
+		if _, err := strconv.ParseUint(tokens[0], 10, 64); err == nil {
+			return tokens[0], tokens[1:]
+		}
+	}
+
+	return "", tokens
+}
+
+func (h *squidHelper) reply(channel, result string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if channel != "" {
+		h.w.WriteString(channel + " ")
+	}
+
+	h.w.WriteString(result + "\n")
+	h.w.Flush()
+}
+
+// check - helper result for the request, the first token is the URL, the domain or the address.
+func (h *squidHelper) check(tokens []string) string {
+	if len(tokens) == 0 {
+		return "BH message=empty"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	ids, err := h.search(ctx, squidUnquote(tokens[0]))
+	if err != nil {
+		logger.Error.Printf("Check error: %s\n", err.Error())
+
+		return "BH message=" + url.QueryEscape(err.Error())
+	}
+
+	if len(ids) == 0 {
+		return "ERR"
+	}
+
+	list := make([]string, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, strconv.Itoa(int(id)))
+	}
+
+	return "OK tag=" + list[0] + " ids=" + strings.Join(list, ",")
+}
+
+// search - content IDs blocking the URL or the host.
+func (h *squidHelper) search(ctx context.Context, query string) ([]int32, error) {
+	var responses []*pb.SearchResponse
+
+	r, err := lookup.ParseResource(query)
+	if err != nil {
+		return nil, nil
+	}
+
+	if r.URL != "" {
+		resp, err := h.client.SearchURL(ctx, &pb.URLRequest{Query: r.URL})
+		if err != nil {
+			return nil, err
+		}
+
+		responses = append(responses, resp)
+	}
+
+	var resp *pb.SearchResponse
+
+	switch {
+	case r.IP == nil:
+		resp, err = h.client.SearchDomain(ctx, &pb.DomainRequest{Query: r.Domain})
+	case len(r.IP) == net.IPv4len:
+		resp, err = h.client.SearchIPv4(ctx, &pb.IPv4Request{Query: IPv4StrToInt(r.IP.String())})
+	default:
+		resp, err = h.client.SearchIPv6(ctx, &pb.IPv6Request{Query: r.IP})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	responses = append(responses, resp)
+
+	var ids []int32
+
+	seen := make(Int32Map)
+
+	for _, resp := range responses {
+		if resp.GetError() != "" {
+			return nil, fmt.Errorf("%s", resp.GetError())
+		}
+
+		for _, content := range resp.GetResults() {
+			if _, ok := seen[content.GetId()]; !ok {
+				seen[content.GetId()] = Nothing{}
+				ids = append(ids, content.GetId())
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// squidUnquote - Squid URL-encodes the helper format fields.
+func squidUnquote(s string) string {
+	if u, err := url.PathUnescape(s); err == nil {
+		return u
+	}
+
+	return s
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestSquidHelper(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	listen, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverGRPC := grpc.NewServer()
+	pb.RegisterCheckServer(serverGRPC, &server{})
+
+	go serverGRPC.Serve(listen)
+	defer serverGRPC.Stop()
+
+	conn, err := grpc.NewClient(listen.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	helper := &squidHelper{client: pb.NewCheckClient(conn), timeout: 5 * time.Second}
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"url", "https://www.e01.tld/sex", "OK tag=111 ids=111"},
+		{"url host", "http://WWW.E02.tld/any", "OK tag=222 ids=222,555"},
+		{"encoded", "https%3A//www.e01.tld/sex", "OK tag=111 ids=111"},
+		{"connect", "www.e02.tld:443", "OK tag=222 ids=222,555"},
+		{"ip", "10.4.1.1", "OK tag=444 ids=444"},
+		{"ipv6 connect", "[fd44:4::1]:443", "OK tag=444 ids=444"},
+		{"not blocked", "http://example.com/", "ERR"},
+		{"empty", "", "BH message=empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			if err := helper.serve(strings.NewReader(tt.in+"\n"), &out); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := strings.TrimSpace(out.String()); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	// concurrent requests are answered with their channel IDs in any order.
+	var out bytes.Buffer
+
+	if err := helper.serve(strings.NewReader("0 https://www.e01.tld/sex\n1 http://example.com/ -\n2 10.4.1.1\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	sort.Strings(lines)
+
+	want := []string{"0 OK tag=111 ids=111", "1 ERR", "2 OK tag=444 ids=444"}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("Expected %v, got %v", want, lines)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrRefreshBusy = errors.New("refresh or reload is already running")
+)
+
+// Refresh sources.
+const (
+	sourcePoll    = "poll"
+	sourceRefresh = "refresh"
+	sourceReload  = "reload"
+	sourceUpload  = "upload"
+	sourceWatch   = "watch"
+)
+
+// refreshMutex - serializes refreshes and reloads, Parse is not reentrant.
+var refreshMutex sync.Mutex
+
+// dumpStatus - the polling state, the current dump and the last attempt.
+type dumpStatus struct {
+	sync.Mutex
+
+	paused bool
+	busy   bool
+
+	currentID   string
+	currentTime int64
+
+	attemptID     string
+	attemptSource string
+	attemptTime   int64
+	attemptError  string
+}
+
+// DumpStatus - the status of the dump refreshes.
+var DumpStatus = &dumpStatus{}
+
+// begin - the refresh or the reload is started, id is empty if it is unknown yet.
+func (status *dumpStatus) begin(source, id string) {
+	status.Lock()
+	defer status.Unlock()
+
+	status.busy = true
+	status.attemptID, status.attemptSource, status.attemptTime, status.attemptError = id, source, time.Now().Unix(), ""
+}
+
+// attempt - the dump ID of the running refresh is known.
+func (status *dumpStatus) attempt(id string) {
+	status.Lock()
+	defer status.Unlock()
+
+	status.attemptID = id
+}
+
+// applied - the dump is parsed.
+func (status *dumpStatus) applied(id string) {
+	status.Lock()
+	defer status.Unlock()
+
+	status.currentID, status.currentTime = id, time.Now().Unix()
+}
+
+// end - the refresh or the reload is finished.
+func (status *dumpStatus) end(err error) {
+	status.Lock()
+	defer status.Unlock()
+
+	status.busy = false
+
+	if err != nil {
+		status.attemptError = err.Error()
+
+		logger.Error.Printf("Dump %s error: %s\n", status.attemptSource, err.Error())
+	}
+}
+
+func (status *dumpStatus) setPaused(paused bool) {
+	status.Lock()
+	defer status.Unlock()
+
+	status.paused = paused
+}
+
+func (status *dumpStatus) Paused() bool {
+	status.Lock()
+	defer status.Unlock()
+
+	return status.paused
+}
+
+// response - the status, err is the error of the request itself.
+func (status *dumpStatus) response(err error) *pb.StatusResponse {
+	var utime int64
+
+	if dump := CurrentDump; dump != nil {
+		dump.RLock()
+		utime = dump.utime
+		dump.RUnlock()
+	}
+
+	status.Lock()
+	defer status.Unlock()
+
+	resp := &pb.StatusResponse{
+		RegistryUpdateTime: utime,
+		Paused:             status.paused,
+		Busy:               status.busy,
+		CurrentID:          status.currentID,
+		CurrentTime:        status.currentTime,
+		AttemptID:          status.attemptID,
+		AttemptSource:      status.attemptSource,
+		AttemptTime:        status.attemptTime,
+		AttemptError:       status.attemptError,
+	}
+
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp
+}
+
+// refreshWith - runs the refresh or the reload in the background, false if another one is running.
+func refreshWith(source, id string, fn func() error) bool {
+	if !refreshMutex.TryLock() {
+		return false
+	}
+
+	DumpStatus.begin(source, id)
+
+	go func() {
+		defer refreshMutex.Unlock()
+
+		DumpStatus.end(fn())
+	}()
+
+	return true
+}
+
+// reloadDump - parses the local dump file, zipped or plain.
+func reloadDump(filename string) error {
+	if err := parseDumpFile(filename); err != nil {
+		return err
+	}
+
+	DumpStatus.applied(filename)
+
+	return nil
+}
+
+// parseProgress - progress of the running or the last parse.
+type parseProgress struct {
+	running    atomic.Bool
+	started    atomic.Int64
+	finished   atomic.Int64
+	bytesRead  atomic.Int64
+	bytesTotal atomic.Int64
+	records    atomic.Int32
+}
+
+// ParseProgress - progress of Parse.
+var ParseProgress = &parseProgress{}
+
+func (progress *parseProgress) begin(total int64) {
+	progress.bytesRead.Store(0)
+	progress.bytesTotal.Store(total)
+	progress.records.Store(0)
+	progress.finished.Store(0)
+	progress.started.Store(time.Now().Unix())
+	progress.running.Store(true)
+}
+
+func (progress *parseProgress) end() {
+	progress.finished.Store(time.Now().Unix())
+	progress.running.Store(false)
+}
+
+func (progress *parseProgress) response() *pb.ProgressResponse {
+	return &pb.ProgressResponse{
+		Running:    progress.running.Load(),
+		Started:    progress.started.Load(),
+		Finished:   progress.finished.Load(),
+		BytesRead:  progress.bytesRead.Load(),
+		BytesTotal: progress.bytesTotal.Load(),
+		Records:    progress.records.Load(),
+	}
+}
+
+// progressReader - counts the bytes read by the parser.
+type progressReader struct {
+	r        io.Reader
+	progress *parseProgress
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	pr.progress.bytesRead.Add(int64(n))
+
+	return n, err
+}
+
+// sizedReader - the reader with the known size, e.g. the zipped file.
+type sizedReader struct {
+	io.Reader
+	size int64
+}
+
+func (sr sizedReader) Size() int64 {
+	return sr.size
+}
+
+// readerSize - size of the file or the sized reader, 0 if it is unknown.
+func readerSize(r io.Reader) int64 {
+	switch r := r.(type) {
+	case interface{ Size() int64 }:
+		return r.Size()
+	case *os.File:
+		if fi, err := r.Stat(); err == nil && fi.Mode().IsRegular() {
+			return fi.Size()
+		}
+	}
+
+	return 0
+}
@@ -25,4 +25,10 @@ type SummaryValues struct {
 	MaxItemReferences             int            `json:"max_item_references"`                // Max number of references to a single item
 	MaxItemReferencesString       string         `json:"max_item_references_string"`         // String representation of max number of references to a single item
 	EntriesWithoutDecisionNo      int            `json:"entries_without_decision_no"`        // Number of entries without decision No
+	Count                         int            `json:"count"`                              // Number of content entries in the dump
+	Added                         int            `json:"added"`                              // Number of content entries added by the dump
+	Updated                       int            `json:"updated"`                            // Number of content entries updated by the dump
+	Removed                       int            `json:"removed"`                            // Number of content entries removed by the dump
+	RepairedElements              int            `json:"repaired_elements"`                  // Number of elements repaired by the linter
+	QuarantinedElements           int            `json:"quarantined_elements"`               // Number of invalid elements kept out of the indexes
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"time"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// TombstoneRetention - how long removed records stay searchable.
+var TombstoneRetention = 7 * 24 * time.Hour
+
+// Tombstones - recently removed records with their own indexes.
+// It is protected by the lock of the owning dump.
+type Tombstones struct {
+	*Dump
+	RemovedTime map[int32]int64 // registry update time of the dump the record disappeared from.
+}
+
+func NewTombstones() *Tombstones {
+	return &Tombstones{
+		Dump:        newDump(),
+		RemovedTime: make(map[int32]int64),
+	}
+}
+
+// bury - keep removed record as a tombstone.
+func (t *Tombstones) bury(cont *PackedContent, utime int64) {
+	if prev, ok := t.ContentIndex[cont.ID]; ok {
+		t.removeFromIndexes(prev)
+	}
+
+	t.ContentIndex[cont.ID] = cont
+	t.RemovedTime[cont.ID] = utime
+	t.insertToIndexes(cont)
+}
+
+// dig - forget the tombstone.
+func (t *Tombstones) dig(id int32) {
+	if cont, ok := t.ContentIndex[id]; ok {
+		t.removeFromIndexes(cont)
+
+		delete(t.ContentIndex, id)
+		delete(t.RemovedTime, id)
+	}
+}
+
+// refresh - forget tombstones of records present in the dump again and expired tombstones.
+func (t *Tombstones) refresh(existed Int32Map, utime int64) {
+	if t == nil {
+		return
+	}
+
+	expired := utime - int64(TombstoneRetention/time.Second)
+
+	for id := range t.ContentIndex {
+		if _, ok := existed[id]; ok || t.RemovedTime[id] < expired {
+			t.dig(id)
+		}
+	}
+
+	t.utime = utime
+	t.buildTimeIndexes()
+}
+
+// search - run the search over live records and, if asked, over tombstones.
+// Expects the dump to be read locked by the caller.
+func (dump *Dump) search(includeRemoved bool, fn func(*Dump) []Match) []Match {
+	matches := fn(dump)
+
+	if !includeRemoved || dump.tombstones == nil {
+		return matches
+	}
+
+	for _, m := range fn(dump.tombstones.Dump) {
+		if _, ok := dump.ContentIndex[m.ID]; ok {
+			continue
+		}
+
+		m.Removed = true
+		matches = append(matches, m)
+	}
+
+	return matches
+}
+
+// content - live record or tombstone for the match.
+func (dump *Dump) content(m Match) (*PackedContent, bool) {
+	if m.Removed {
+		if dump.tombstones == nil {
+			return nil, false
+		}
+
+		cont, ok := dump.tombstones.ContentIndex[m.ID]
+
+		return cont, ok
+	}
+
+	cont, ok := dump.ContentIndex[m.ID]
+
+	return cont, ok
+}
+
+// newPbTombstone - protobuf content for the tombstone.
+func (t *Tombstones) newPbTombstone(cont *PackedContent, reason pb.MatchReason, key string) *pb.Content {
+	v0 := cont.newPbContent(reason, key)
+	v0.Removed = true
+	v0.RemovedTime = t.RemovedTime[cont.ID]
+
+	return v0
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// dumpWithout - xml01 dated with updateTime without the given content.
+func dumpWithout(updateTime string, id string) string {
+	dump := strings.Replace(xml01, `updateTime="2011-01-01T01:01:01+03:00"`, `updateTime="`+updateTime+`"`, 1)
+
+	start := strings.Index(dump, `<content id="`+id+`"`)
+	if start < 0 {
+		return dump
+	}
+
+	end := strings.Index(dump[start:], "</content>") + len("</content>")
+
+	return dump[:start] + dump[start+end:]
+}
+
+func TestTombstones(t *testing.T) {
+	CurrentDump = NewDump()
+
+	if err := Parse(strings.NewReader(xml01)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Parse(strings.NewReader(dumpWithout("2011-01-02T01:01:01+03:00", "555"))); err != nil {
+		t.Fatal(err)
+	}
+
+	live := CurrentDump.search(false, func(dump *Dump) []Match { return dump.searchDomain("www.e02.tld") })
+	if len(live) != 1 || live[0].ID != 222 {
+		t.Errorf("Expected only 222 alive, got %v", live)
+	}
+
+	all := CurrentDump.search(true, func(dump *Dump) []Match { return dump.searchDomain("www.e02.tld") })
+	if len(all) != 2 || all[1].ID != 555 || !all[1].Removed {
+		t.Fatalf("Expected 555 tombstone, got %v", all)
+	}
+
+	results := CurrentDump.contents(all)
+	if len(results) != 2 || !results[1].Removed || results[1].RemovedTime != parseRFC3339Time("2011-01-02T01:01:01+03:00") {
+		t.Errorf("Tombstone result error: %v", results[1])
+	}
+
+	// tombstone expires after retention.
+	expired := time.Unix(parseRFC3339Time("2011-01-02T01:01:01+03:00"), 0).Add(TombstoneRetention + time.Hour)
+	if err := Parse(strings.NewReader(dumpWithout(expired.Format(time.RFC3339), "555"))); err != nil {
+		t.Fatal(err)
+	}
+
+	all = CurrentDump.search(true, func(dump *Dump) []Match { return dump.searchDomain("www.e02.tld") })
+	if len(all) != 1 {
+		t.Errorf("Expected expired tombstone, got %v", all)
+	}
+
+	// tombstone is forgotten when the record comes back.
+	if err := Parse(strings.NewReader(dumpWithout(expired.Add(time.Hour).Format(time.RFC3339), "444"))); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Parse(strings.NewReader(dumpWithout(expired.Add(2*time.Hour).Format(time.RFC3339), ""))); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(CurrentDump.tombstones.ContentIndex) != 0 {
+		t.Errorf("Expected no tombstones, got %d", len(CurrentDump.tombstones.ContentIndex))
+	}
+}
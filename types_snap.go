@@ -1,5 +1,7 @@
 package main
 
+import pb "github.com/usher2/u2ckdump/msg"
+
 // Block types: url, https, domain, mask, ip.
 const (
 	BlockTypeURL = iota
@@ -9,6 +11,24 @@ const (
 	BlockTypeIP
 )
 
+// blockTypeName - block type name for humans.
+func blockTypeName(blockType int32) string {
+	switch blockType {
+	case BlockTypeURL:
+		return "url"
+	case BlockTypeHTTPS:
+		return "https"
+	case BlockTypeDomain:
+		return "domain"
+	case BlockTypeMask:
+		return "mask"
+	case BlockTypeIP:
+		return "ip"
+	default:
+		return "unknown"
+	}
+}
+
 // PackedContent - packed version of Content.
 type PackedContent struct {
 	ID                 int32
@@ -16,7 +36,10 @@ type PackedContent struct {
 	EntryTypeString    string
 	BlockType          int32 // for protobuf
 	RegistryUpdateTime int64
+	IncludeTime        int64
+	Ts                 int64
 	Decision           uint64
+	DecisionDate       int64
 	DecisionOrg        string
 	DecisionNumber     string
 	URL                []URL
@@ -31,22 +54,23 @@ type PackedContent struct {
 
 // Content - store for <content> with hash.
 type Content struct {
-	ID          int32        `json:"id"`
-	EntryType   int32        `json:"et"`
-	UrgencyType int32        `json:"ut,omitempty"`
-	Decision    Decision     `json:"d"`
-	IncludeTime int64        `json:"it"`
-	Ts          int64        `json:"ts,omitempty"`
-	BlockType   string       `json:"bt,omitempty"`
-	Hash        string       `json:"h"`
-	URL         []URL        `json:"url,omitempty"`
-	IPv4        []IPv4       `json:"ip4,omitempty"`
-	IPv6        []IPv6       `json:"ip6,omitempty"`
-	SubnetIPv4  []SubnetIPv4 `json:"sb4,omitempty"`
-	SubnetIPv6  []SubnetIPv6 `json:"sb6,omitempty"`
-	Domain      []Domain     `json:"dm,omitempty"`
-	HTTPSBlock  int          `json:"hb"`
-	RecordHash  uint64       `json:"u2h"`
+	ID          int32             `json:"id"`
+	EntryType   int32             `json:"et"`
+	UrgencyType int32             `json:"ut,omitempty"`
+	Decision    Decision          `json:"d"`
+	IncludeTime int64             `json:"it"`
+	Ts          int64             `json:"ts,omitempty"`
+	BlockType   string            `json:"bt,omitempty"`
+	Hash        string            `json:"h"`
+	URL         []URL             `json:"url,omitempty"`
+	IPv4        []IPv4            `json:"ip4,omitempty"`
+	IPv6        []IPv6            `json:"ip6,omitempty"`
+	SubnetIPv4  []SubnetIPv4      `json:"sb4,omitempty"`
+	SubnetIPv6  []SubnetIPv6      `json:"sb6,omitempty"`
+	Domain      []Domain          `json:"dm,omitempty"`
+	HTTPSBlock  int               `json:"hb"`
+	RecordHash  uint64            `json:"u2h"`
+	Lint        []*pb.LintElement `json:"-"` // repaired and invalid elements.
 }
 
 // SubnetIPv6 - store for <ipv6Subnet>.
@@ -0,0 +1,615 @@
+// u2ckc - command line client of the u2ckdump Check service.
+//
+//	u2ckc [flags] check 1.2.3.4 example.com https://example.com/path
+//	u2ckc -o csv lookup-by-id 100 200 < ids.txt
+//	u2ckc -s host:50001 -tls -ca ca.pem -token secret watch
+//	u2ckc -s unix:///run/u2ckdump.sock admin-status
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+	"github.com/usher2/u2ckdump/internal/lookup"
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Errors
+var (
+	ErrUnknownCommand = errors.New("unknown command")
+	ErrBadQuery       = lookup.ErrBadQuery
+	ErrBadCA          = errors.New("no certificates in the CA file")
+)
+
+const usage = `Usage: u2ckc [flags] <command> [queries]
+
+Commands:
+  check         IPv4, IPv6, domain or URL (URLs are checked by the host too)
+  lookup-by-id  content ID
+  decision      decision hash or "org|number|date"
+  org           decision org
+  entry-type    entry type key, e.g. 15_1
+  summary       registry summary
+  watch         registry summary on every update
+
+Admin commands, -s is the admin service address:
+  admin-status    polling state, the current dump and the last attempt
+  admin-progress  progress of the running or the last parse
+  admin-refresh   fetch and parse the last dump now
+  admin-reload    parse the local dump file of the server, the argument
+  admin-pause     pause the polling
+  admin-resume    resume the polling
+  admin-upload    upload dump.zip, the argument, and apply it
+
+Queries are the arguments, "-" is stdin, "@file" is the file with one query per line.
+Without arguments queries are read from stdin.
+
+Flags:
+`
+
+// config - client options.
+type config struct {
+	server         string
+	timeout        time.Duration
+	output         string
+	pageSize       int
+	includeRemoved bool
+	asOf           int64
+	interval       time.Duration
+
+	tls        bool
+	ca         string
+	cert       string
+	key        string
+	serverName string
+	skipVerify bool
+	token      string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	cfg := &config{}
+
+	var asOf string
+
+	fs := flag.NewFlagSet("u2ckc", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&cfg.server, "s", "localhost:50001", "Check service address")
+	fs.DurationVar(&cfg.timeout, "timeout", 10*time.Second, "Request timeout")
+	fs.StringVar(&cfg.output, "o", outputTable, "Output format: table, json or csv")
+	fs.IntVar(&cfg.pageSize, "page-size", 0, "Page size, 0 is the server default, all pages are fetched")
+	fs.BoolVar(&cfg.includeRemoved, "removed", false, "Include removed records")
+	fs.StringVar(&asOf, "as-of", "", "Search the registry as it was at the time, RFC 3339")
+	fs.DurationVar(&cfg.interval, "interval", time.Minute, "Watch poll interval")
+	fs.BoolVar(&cfg.tls, "tls", false, "Use TLS")
+	fs.StringVar(&cfg.ca, "ca", "", "CA certificates file, empty is the system pool")
+	fs.StringVar(&cfg.cert, "cert", "", "Client certificate file")
+	fs.StringVar(&cfg.key, "key", "", "Client key file")
+	fs.StringVar(&cfg.serverName, "server-name", "", "TLS server name, empty is the address host")
+	fs.BoolVar(&cfg.skipVerify, "insecure-skip-verify", false, "Don't verify the server certificate")
+	fs.StringVar(&cfg.token, "token", os.Getenv("U2CKC_TOKEN"), "Bearer token, U2CKC_TOKEN by default")
+	fs.Parse(args)
+
+	logger.LogInit(io.Discard, io.Discard, os.Stderr, os.Stderr)
+
+	if asOf != "" {
+		t, err := time.Parse(time.RFC3339, asOf)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Bad -as-of: %s\n", err.Error())
+
+			return 2
+		}
+
+		cfg.asOf = t.Unix()
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+
+		return 2
+	}
+
+	command, queries := fs.Arg(0), fs.Args()[1:]
+
+	switch command {
+	case "check", "lookup-by-id", "decision", "org", "entry-type", "summary", "watch":
+	case "admin-status", "admin-progress", "admin-refresh", "admin-reload", "admin-pause", "admin-resume", "admin-upload":
+	default:
+		fmt.Fprintf(os.Stderr, "%s: %s\n", ErrUnknownCommand.Error(), command)
+		fs.Usage()
+
+		return 2
+	}
+
+	out, err := newOutput(cfg.output, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+
+		return 2
+	}
+
+	opts, err := dialOptions(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't configure connection: %s\n", err.Error())
+
+		return 1
+	}
+
+	conn, err := grpc.NewClient(cfg.server, opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't connect: %s\n", err.Error())
+
+		return 1
+	}
+
+	defer conn.Close()
+
+	c := &client{cfg: cfg, check: pb.NewCheckClient(conn), admin: pb.NewAdminClient(conn), out: out}
+
+	switch {
+	case command == "summary":
+		err = c.summary()
+	case command == "watch":
+		err = c.watch()
+	case strings.HasPrefix(command, "admin-"):
+		err = c.adminCommand(command, queries)
+	default:
+		err = c.search(command, queries)
+	}
+
+	if ferr := out.Flush(); err == nil {
+		err = ferr
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err.Error())
+
+		return 1
+	}
+
+	return 0
+}
+
+// dialOptions - transport credentials and the bearer token.
+func dialOptions(cfg *config) ([]grpc.DialOption, error) {
+	if !cfg.tls {
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+		if cfg.token != "" {
+			opts = append(opts, grpc.WithPerRPCCredentials(tokenAuth{token: cfg.token}))
+		}
+
+		return opts, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         cfg.serverName,
+		InsecureSkipVerify: cfg.skipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if cfg.ca != "" {
+		b, err := os.ReadFile(cfg.ca)
+		if err != nil {
+			return nil, fmt.Errorf("read CA: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(b) {
+			return nil, ErrBadCA
+		}
+	}
+
+	if cfg.cert != "" || cfg.key != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.cert, cfg.key)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+	if cfg.token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenAuth{token: cfg.token, secure: true}))
+	}
+
+	return opts, nil
+}
+
+// tokenAuth - authorization: Bearer <token> metadata.
+type tokenAuth struct {
+	token  string
+	secure bool
+}
+
+func (a tokenAuth) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + a.token}, nil
+}
+
+func (a tokenAuth) RequireTransportSecurity() bool {
+	return a.secure
+}
+
+// client - commands.
+type client struct {
+	cfg   *config
+	check pb.CheckClient
+	admin pb.AdminClient
+	out   output
+}
+
+// search - runs the search command for every query, errors of single queries don't stop the others.
+func (c *client) search(command string, queries []string) error {
+	var failed int
+
+	err := readQueries(queries, os.Stdin, func(query string) {
+		if err := c.searchOne(command, query); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", query, err.Error())
+
+			failed++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d queries failed", failed)
+	}
+
+	return nil
+}
+
+func (c *client) searchOne(command, query string) error {
+	switch command {
+	case "check":
+		return c.checkQuery(query)
+	case "lookup-by-id":
+		id, err := strconv.ParseInt(query, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrBadQuery, err)
+		}
+
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchContentID(ctx, &pb.ContentIDRequest{Query: int32(id), Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	case "decision":
+		hash, err := lookup.Decision(query)
+		if err != nil {
+			return err
+		}
+
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchDecision(ctx, &pb.DecisionRequest{Query: hash, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	case "org":
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchOrg(ctx, &pb.OrgRequest{Query: lookup.Hash(query), Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	case "entry-type":
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchEntryType(ctx, &pb.EntryTypeRequest{Query: query, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownCommand, command)
+	}
+}
+
+// checkQuery - search by the kind of the query, URLs are searched by the host too.
+func (c *client) checkQuery(query string) error {
+	r, err := lookup.ParseResource(query)
+	if err != nil {
+		return err
+	}
+
+	if r.URL != "" {
+		if err := c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchURL(ctx, &pb.URLRequest{Query: r.URL, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		}); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case r.IP == nil:
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchDomain(ctx, &pb.DomainRequest{Query: r.Domain, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	case len(r.IP) == net.IPv4len:
+		query4 := uint32(r.IP[0])<<24 | uint32(r.IP[1])<<16 | uint32(r.IP[2])<<8 | uint32(r.IP[3])
+
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchIPv4(ctx, &pb.IPv4Request{Query: query4, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	default:
+		return c.fetch(query, func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error) {
+			return c.check.SearchIPv6(ctx, &pb.IPv6Request{Query: r.IP, Page: page, IncludeRemoved: c.cfg.includeRemoved, AsOf: c.cfg.asOf})
+		})
+	}
+}
+
+// fetch - all pages of the search, every result is written.
+func (c *client) fetch(query string, search func(ctx context.Context, page *pb.Page) (*pb.SearchResponse, error)) error {
+	page := &pb.Page{PageSize: int32(c.cfg.pageSize)}
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
+		resp, err := search(ctx, page)
+
+		cancel()
+
+		if err != nil {
+			return err
+		}
+
+		if resp.GetError() != "" {
+			return fmt.Errorf("%s", resp.GetError())
+		}
+
+		for _, content := range resp.GetResults() {
+			c.out.Result(newResultRow(query, content))
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+
+		page.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// summary - the registry summary.
+func (c *client) summary() error {
+	summary, err := c.fetchSummary()
+	if err != nil {
+		return err
+	}
+
+	return c.out.Summary(summary)
+}
+
+func (c *client) fetchSummary() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
+	defer cancel()
+
+	resp, err := c.check.Summary(ctx, &pb.SummaryRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetError() != "" {
+		return nil, fmt.Errorf("%s", resp.GetError())
+	}
+
+	return resp.GetSummary(), nil
+}
+
+// adminCommand - runs the admin command, the response is written as the summary.
+func (c *client) adminCommand(command string, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.timeout)
+	defer cancel()
+
+	var (
+		resp interface {
+			proto.Message
+			GetError() string
+		}
+		err error
+	)
+
+	switch command {
+	case "admin-status":
+		resp, err = c.admin.Status(ctx, &pb.StatusRequest{})
+	case "admin-progress":
+		resp, err = c.admin.Progress(ctx, &pb.ProgressRequest{})
+	case "admin-refresh":
+		resp, err = c.admin.Refresh(ctx, &pb.RefreshRequest{})
+	case "admin-reload":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: want one dump file", ErrBadQuery)
+		}
+
+		resp, err = c.admin.Reload(ctx, &pb.ReloadRequest{Filename: args[0]})
+	case "admin-pause", "admin-resume":
+		resp, err = c.admin.Pause(ctx, &pb.PauseRequest{Paused: command == "admin-pause"})
+	case "admin-upload":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: want one dump archive", ErrBadQuery)
+		}
+
+		upload, err := c.upload(args[0])
+		if err != nil {
+			return err
+		}
+
+		if upload.GetError() != "" {
+			return fmt.Errorf("%s", upload.GetError())
+		}
+
+		// the summary of the parsed dump.
+		return c.out.Summary(upload.GetSummary())
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownCommand, command)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("encode response: %w", err)
+	}
+
+	if err := c.out.Summary(b); err != nil {
+		return err
+	}
+
+	if resp.GetError() != "" {
+		return fmt.Errorf("%s", resp.GetError())
+	}
+
+	return nil
+}
+
+// uploadChunkSize - below the default grpc message limit.
+const uploadChunkSize = 1 << 20
+
+// upload - streams the archive with its sha256, it is not limited by -timeout, the parse takes minutes.
+func (c *client) upload(filename string) (*pb.UploadResponse, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("open archive: %w", err)
+	}
+
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+
+	stream, err := c.admin.Upload(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &pb.UploadChunk{Sha256: hex.EncodeToString(hash.Sum(nil)), Name: filepath.Base(filename)}
+	buf := make([]byte, uploadChunkSize)
+
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+
+			if err := stream.Send(chunk); err != nil {
+				// the server has closed the stream, the reason is in CloseAndRecv.
+				break
+			}
+
+			chunk = &pb.UploadChunk{}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// watch - polls the summary and writes it on every registry update till the signal.
+func (c *client) watch() error {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	ticker := time.NewTicker(c.cfg.interval)
+	defer ticker.Stop()
+
+	var last int64
+
+	for {
+		summary, err := c.fetchSummary()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Summary: %s\n", err.Error())
+		} else if utime := summaryUpdateTime(summary); utime != last {
+			last = utime
+
+			if err := c.out.Summary(summary); err != nil {
+				return err
+			}
+
+			if err := c.out.Flush(); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-quit:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// readQueries - queries from the arguments, "-" is the reader, "@file" is the file.
+// Without arguments queries are read from the reader. Empty lines and # comments are skipped.
+func readQueries(args []string, stdin io.Reader, fn func(query string)) error {
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+
+	for _, arg := range args {
+		switch {
+		case arg == "-":
+			if err := scanQueries(stdin, fn); err != nil {
+				return fmt.Errorf("read stdin: %w", err)
+			}
+		case strings.HasPrefix(arg, "@"):
+			f, err := os.Open(arg[1:])
+			if err != nil {
+				return fmt.Errorf("open queries: %w", err)
+			}
+
+			err = scanQueries(f, fn)
+			f.Close()
+
+			if err != nil {
+				return fmt.Errorf("read %s: %w", arg[1:], err)
+			}
+		default:
+			fn(arg)
+		}
+	}
+
+	return nil
+}
+
+func scanQueries(r io.Reader, fn func(query string)) error {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		if query := strings.TrimSpace(scanner.Text()); query != "" && !strings.HasPrefix(query, "#") {
+			fn(query)
+		}
+	}
+
+	return scanner.Err()
+}
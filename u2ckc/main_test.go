@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+func TestReadQueries(t *testing.T) {
+	var got []string
+
+	err := readQueries([]string{"1.1.1.1", "-", "example.com"}, strings.NewReader("# comment\n\n  100  \n200\n"), func(query string) {
+		got = append(got, query)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "1.1.1.1,100,200,example.com"; strings.Join(got, ",") != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	content := &pb.Content{
+		Id:       111,
+		Match:    pb.MatchReason_MATCH_DOMAIN,
+		MatchKey: "www.e01.tld",
+		Record: &pb.ContentRecord{
+			Id:           111,
+			EntryTypeKey: "15_1",
+			HttpsBlock:   1,
+			Decision:     &pb.Decision{Org: "ONE", Number: "1/1/11-1111", Date: "2000-01-01"},
+		},
+	}
+
+	summary := []byte(`{"UpdateTime":1293832861,"content_entries":5,"entry_types":{"15_1":5}}`)
+
+	tests := []struct {
+		format  string
+		result  string
+		summary string
+	}{
+		{outputCSV,
+			"query,id,block_type,entry_type,org,number,date,match,match_key,removed\nWWW.e01.tld,111,https,15_1,ONE,1/1/11-1111,2000-01-01,MATCH_DOMAIN,www.e01.tld,\n",
+			"UpdateTime,1293832861\ncontent_entries,5\nentry_types.15_1,5\n"},
+		{outputJSON,
+			`{"query":"WWW.e01.tld","id":111,"block_type":"https","entry_type":"15_1","org":"ONE","number":"1/1/11-1111","date":"2000-01-01","match":"MATCH_DOMAIN","match_key":"www.e01.tld"}` + "\n",
+			string(summary) + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var b strings.Builder
+
+			out, err := newOutput(tt.format, &b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out.Result(newResultRow("WWW.e01.tld", content))
+			out.Flush()
+
+			if b.String() != tt.result {
+				t.Errorf("Expected %q, got %q", tt.result, b.String())
+			}
+
+			b.Reset()
+
+			if err := out.Summary(summary); err != nil {
+				t.Fatal(err)
+			}
+
+			out.Flush()
+
+			if b.String() != tt.summary {
+				t.Errorf("Expected %q, got %q", tt.summary, b.String())
+			}
+		})
+	}
+
+	if got := summaryUpdateTime(summary); got != 1293832861 {
+		t.Errorf("Expected 1293832861, got %d", got)
+	}
+}
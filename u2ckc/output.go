@@ -0,0 +1,251 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/usher2/u2ckdump/msg"
+)
+
+// Output formats.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+// Errors
+var (
+	ErrUnknownOutput = errors.New("unknown output format")
+)
+
+// resultRow - search result as it is written.
+type resultRow struct {
+	Query     string `json:"query"`
+	ID        int32  `json:"id"`
+	BlockType string `json:"block_type"`
+	EntryType string `json:"entry_type"`
+	Org       string `json:"org"`
+	Number    string `json:"number"`
+	Date      string `json:"date"`
+	Match     string `json:"match"`
+	MatchKey  string `json:"match_key"`
+	Removed   string `json:"removed,omitempty"` // removal time, RFC 3339.
+}
+
+var resultHeader = []string{"query", "id", "block_type", "entry_type", "org", "number", "date", "match", "match_key", "removed"}
+
+func (r *resultRow) fields() []string {
+	return []string{r.Query, strconv.Itoa(int(r.ID)), r.BlockType, r.EntryType, r.Org, r.Number, r.Date, r.Match, r.MatchKey, r.Removed}
+}
+
+func newResultRow(query string, content *pb.Content) *resultRow {
+	rec := content.GetRecord()
+
+	row := &resultRow{
+		Query:     query,
+		ID:        content.GetId(),
+		BlockType: blockTypeName(rec),
+		EntryType: rec.GetEntryTypeKey(),
+		Org:       rec.GetDecision().GetOrg(),
+		Number:    rec.GetDecision().GetNumber(),
+		Date:      rec.GetDecision().GetDate(),
+		MatchKey:  content.GetMatchKey(),
+	}
+
+	if content.GetMatch() != pb.MatchReason_MATCH_NONE {
+		row.Match = content.GetMatch().String()
+	}
+
+	if content.GetRemoved() {
+		row.Removed = time.Unix(content.GetRemovedTime(), 0).UTC().Format(time.RFC3339)
+	}
+
+	return row
+}
+
+// blockTypeName - url, https, domain, mask or ip as the server names block types.
+func blockTypeName(rec *pb.ContentRecord) string {
+	switch rec.GetBlockType() {
+	case "", "default":
+		if rec.GetHttpsBlock() > 0 {
+			return "https"
+		}
+
+		return "url"
+	case "domain-mask":
+		return "mask"
+	default:
+		return rec.GetBlockType()
+	}
+}
+
+// output - writer of results and summaries.
+type output interface {
+	Result(row *resultRow)
+	Summary(summary []byte) error
+	Flush() error
+}
+
+func newOutput(format string, w io.Writer) (output, error) {
+	switch format {
+	case outputTable:
+		return &tableOutput{w: tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)}, nil
+	case outputJSON:
+		return &jsonOutput{enc: json.NewEncoder(w)}, nil
+	case outputCSV:
+		return &csvOutput{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOutput, format)
+	}
+}
+
+// summaryFields - sorted flattened summary, maps are key.subkey.
+func summaryFields(summary []byte) ([][2]string, error) {
+	var values map[string]any
+
+	if err := json.Unmarshal(summary, &values); err != nil {
+		return nil, fmt.Errorf("decode summary: %w", err)
+	}
+
+	var fields [][2]string
+
+	for key, value := range values {
+		if m, ok := value.(map[string]any); ok {
+			for sub, v := range m {
+				fields = append(fields, [2]string{key + "." + sub, fmt.Sprint(v)})
+			}
+
+			continue
+		}
+
+		if f, ok := value.(float64); ok {
+			fields = append(fields, [2]string{key, strconv.FormatFloat(f, 'f', -1, 64)})
+
+			continue
+		}
+
+		fields = append(fields, [2]string{key, fmt.Sprint(value)})
+	}
+
+	sort.Slice(fields, func(i, j int) bool { return fields[i][0] < fields[j][0] })
+
+	return fields, nil
+}
+
+// summaryUpdateTime - registry update time of the summary.
+func summaryUpdateTime(summary []byte) int64 {
+	var values struct {
+		UpdateTime int64
+	}
+
+	json.Unmarshal(summary, &values)
+
+	return values.UpdateTime
+}
+
+// tableOutput - aligned columns with the header.
+type tableOutput struct {
+	w      *tabwriter.Writer
+	header bool
+}
+
+func (o *tableOutput) Result(row *resultRow) {
+	if !o.header {
+		o.header = true
+
+		o.writeLine(resultHeader)
+	}
+
+	o.writeLine(row.fields())
+}
+
+func (o *tableOutput) Summary(summary []byte) error {
+	fields, err := summaryFields(summary)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		o.writeLine(field[:])
+	}
+
+	fmt.Fprintln(o.w)
+
+	return nil
+}
+
+func (o *tableOutput) writeLine(fields []string) {
+	for i, field := range fields {
+		if i > 0 {
+			fmt.Fprint(o.w, "\t")
+		}
+
+		fmt.Fprint(o.w, field)
+	}
+
+	fmt.Fprintln(o.w)
+}
+
+func (o *tableOutput) Flush() error {
+	return o.w.Flush()
+}
+
+// jsonOutput - one JSON object per line.
+type jsonOutput struct {
+	enc *json.Encoder
+}
+
+func (o *jsonOutput) Result(row *resultRow) {
+	o.enc.Encode(row)
+}
+
+func (o *jsonOutput) Summary(summary []byte) error {
+	return o.enc.Encode(json.RawMessage(summary))
+}
+
+func (o *jsonOutput) Flush() error {
+	return nil
+}
+
+// csvOutput - CSV with the header.
+type csvOutput struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (o *csvOutput) Result(row *resultRow) {
+	if !o.header {
+		o.header = true
+
+		o.w.Write(resultHeader)
+	}
+
+	o.w.Write(row.fields())
+}
+
+func (o *csvOutput) Summary(summary []byte) error {
+	fields, err := summaryFields(summary)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		o.w.Write(field[:])
+	}
+
+	return nil
+}
+
+func (o *csvOutput) Flush() error {
+	o.w.Flush()
+
+	return o.w.Error()
+}
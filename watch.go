@@ -0,0 +1,309 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// watchStateFile - processed archives of the drop directory, it is kept in the cache dir.
+const watchStateFile = "watched"
+
+// dropWatcher - names of the created or changed files of the drop directory.
+// The existing files are sent first.
+type dropWatcher interface {
+	Events() <-chan string
+	Close() error
+}
+
+// watchedFile - the processed archive, the changed one is processed again.
+type watchedFile struct {
+	Size      int64  `json:"size"`
+	ModTime   int64  `json:"mtime"`
+	Processed int64  `json:"processed"`
+	Error     string `json:"error,omitempty"`
+	Skipped   string `json:"skipped,omitempty"` // the reason the archive is not applied.
+}
+
+// watchState - processed archives by name.
+type watchState map[string]watchedFile
+
+// LoadWatchState - the state file, the missing one is the empty state.
+func LoadWatchState(filename string) (watchState, error) {
+	state := make(watchState)
+
+	dat, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	if err := json.Unmarshal(dat, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return state, nil
+}
+
+// Save - writes the state file atomically.
+func (state watchState) Save(filename string) error {
+	dat, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	return writeFileWith(filename, func(w io.Writer) error {
+		_, err := w.Write(dat)
+
+		return err
+	})
+}
+
+// processed - the archive of the same size and modification time is processed already.
+func (state watchState) processed(name string, fi os.FileInfo) bool {
+	file, ok := state[name]
+
+	return ok && file.Size == fi.Size() && file.ModTime == fi.ModTime().UnixNano()
+}
+
+// isDropArchive - zip archives only, hidden files are temporary files of rsync and alike.
+func isDropArchive(name string) bool {
+	return strings.HasSuffix(name, ".zip") && !strings.HasPrefix(name, ".")
+}
+
+// waitStable - waits till the size and the modification time of the file don't change for settle.
+// Nil if the kill channel is closed.
+func waitStable(filename string, settle time.Duration, kill <-chan struct{}) (os.FileInfo, error) {
+	prev, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-time.After(settle):
+		case <-kill:
+			return nil, nil
+		}
+
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		if fi.Size() == prev.Size() && fi.ModTime().Equal(prev.ModTime()) {
+			return fi, nil
+		}
+
+		prev = fi
+	}
+}
+
+// DumpWatch - watches the drop directory for new dump archives instead of polling the dump source.
+// The archive is applied as the fetched one and kept as the cached dump.zip.
+// The watcher is created by the caller, so the failed start is not silent.
+func DumpWatch(done chan<- struct{}, kill <-chan struct{}, watcher dropWatcher, dropDir, dir string, settle time.Duration) {
+	defer close(done)
+	defer watcher.Close()
+
+	stateFile := filepath.Join(dir, watchStateFile)
+
+	state, err := LoadWatchState(stateFile)
+	if err != nil {
+		logger.Error.Printf("Can't load watch state: %s\n", err.Error())
+
+		state = make(watchState)
+	}
+
+	for {
+		select {
+		case name := <-watcher.Events():
+			if !isDropArchive(name) {
+				continue
+			}
+
+			fi, err := waitStable(filepath.Join(dropDir, name), settle, kill)
+			switch {
+			case err != nil:
+				logger.Warning.Printf("Dropped archive %s: %s\n", name, err.Error())
+
+				continue
+			case fi == nil:
+				return
+			case state.processed(name, fi):
+				logger.Debug.Printf("Dropped archive %s is processed already\n", name)
+
+				continue
+			}
+
+			file := watchedFile{Size: fi.Size(), ModTime: fi.ModTime().UnixNano(), Processed: time.Now().Unix()}
+
+			// the failed or the skipped archive is not retried till it is changed.
+			switch err := applyDropArchive(filepath.Join(dropDir, name), dir, name); {
+			case errors.Is(err, ErrArchiveOlder):
+				logger.Warning.Printf("Dropped archive %s is skipped: %s\n", name, err.Error())
+
+				file.Skipped = err.Error()
+			case err != nil:
+				file.Error = err.Error()
+			}
+
+			state[name] = file
+
+			if err := state.Save(stateFile); err != nil {
+				logger.Error.Printf("Can't save watch state: %s\n", err.Error())
+			}
+		case <-kill:
+			return
+		}
+	}
+}
+
+// applyDropArchive - applies the archive and keeps it as the cached dump.zip, so the restart parses it.
+// The archive older than the current dump is skipped, the late backlog import must not rewind the registry.
+func applyDropArchive(filename, dir, name string) error {
+	refreshMutex.Lock()
+	defer refreshMutex.Unlock()
+
+	logger.Info.Printf("New dropped archive: %s\n", name)
+
+	if err := CheckArchiveTime(filename); err != nil {
+		return err
+	}
+
+	DumpStatus.begin(sourceWatch, name)
+
+	err := applyDumpArchive(filename, dir+"/dump.xml")
+	if err == nil {
+		DumpStatus.applied(name)
+
+		err = copyFile(filename, dir+"/dump.zip")
+	}
+
+	DumpStatus.end(err)
+
+	return err
+}
+
+// copyFile - copies the file atomically.
+func copyFile(src, filename string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+
+	defer in.Close()
+
+	return writeFileWith(filename, func(w io.Writer) error {
+		if _, err := io.Copy(w, in); err != nil {
+			return fmt.Errorf("copy file: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// pollWatcher - the portable watcher, it scans the directory every interval.
+type pollWatcher struct {
+	dir      string
+	events   chan string
+	quit     chan struct{}
+	interval time.Duration
+}
+
+func newPollWatcher(dir string, interval time.Duration) (*pollWatcher, error) {
+	if _, err := os.ReadDir(dir); err != nil {
+		return nil, err
+	}
+
+	watcher := &pollWatcher{dir: dir, events: make(chan string), quit: make(chan struct{}), interval: interval}
+
+	go watcher.run()
+
+	return watcher, nil
+}
+
+func (watcher *pollWatcher) Events() <-chan string {
+	return watcher.events
+}
+
+func (watcher *pollWatcher) Close() error {
+	close(watcher.quit)
+
+	return nil
+}
+
+// run - sends the new and the changed files of every scan, the oldest first.
+func (watcher *pollWatcher) run() {
+	seen := make(map[string]time.Time)
+
+	ticker := time.NewTicker(watcher.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, name := range watcher.scan(seen) {
+			select {
+			case watcher.events <- name:
+			case <-watcher.quit:
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-watcher.quit:
+			return
+		}
+	}
+}
+
+func (watcher *pollWatcher) scan(seen map[string]time.Time) []string {
+	entries, err := os.ReadDir(watcher.dir)
+	if err != nil {
+		logger.Error.Printf("Can't scan %s: %s\n", watcher.dir, err.Error())
+
+		return nil
+	}
+
+	var changed []os.FileInfo
+
+	for _, entry := range entries {
+		fi, err := entry.Info()
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+
+		// the size is checked by the consumer, the modification time is enough here.
+		if mtime, ok := seen[fi.Name()]; ok && mtime.Equal(fi.ModTime()) {
+			continue
+		}
+
+		seen[fi.Name()] = fi.ModTime()
+		changed = append(changed, fi)
+	}
+
+	return sortedByModTime(changed)
+}
+
+// sortedByModTime - names of the files, the oldest first.
+func sortedByModTime(files []os.FileInfo) []string {
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime().Before(files[j].ModTime()) })
+
+	names := make([]string, 0, len(files))
+	for _, fi := range files {
+		names = append(names, fi.Name())
+	}
+
+	return names
+}
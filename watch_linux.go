@@ -0,0 +1,143 @@
+//go:build linux
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/usher2/u2ckdump/internal/logger"
+)
+
+// inotifyMask - the file is written and closed or moved in, rsync renames its temporary file.
+const inotifyMask = unix.IN_CLOSE_WRITE | unix.IN_MOVED_TO
+
+// newDropWatcher - inotify watcher, the polling one if inotify is not available.
+func newDropWatcher(dir string, interval time.Duration) (dropWatcher, error) {
+	watcher, err := newInotifyWatcher(dir)
+	if err == nil {
+		return watcher, nil
+	}
+
+	logger.Warning.Printf("Can't use inotify, polling %s: %s\n", dir, err.Error())
+
+	return newPollWatcher(dir, interval)
+}
+
+// inotifyWatcher - the watcher of the drop directory on inotify events.
+type inotifyWatcher struct {
+	dir    string
+	file   *os.File
+	events chan string
+	quit   chan struct{}
+}
+
+func newInotifyWatcher(dir string) (*inotifyWatcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+
+	if _, err := unix.InotifyAddWatch(fd, dir, inotifyMask|unix.IN_ONLYDIR); err != nil {
+		unix.Close(fd)
+
+		return nil, os.NewSyscallError("inotify_add_watch", err)
+	}
+
+	// the non-blocking descriptor is served by the runtime poller, Close interrupts Read.
+	watcher := &inotifyWatcher{dir: dir, file: os.NewFile(uintptr(fd), "inotify"), events: make(chan string), quit: make(chan struct{})}
+
+	go watcher.run()
+
+	return watcher, nil
+}
+
+func (watcher *inotifyWatcher) Events() <-chan string {
+	return watcher.events
+}
+
+func (watcher *inotifyWatcher) Close() error {
+	close(watcher.quit)
+
+	return watcher.file.Close()
+}
+
+// run - sends the existing files, then the names of the events. The queue overflow sends all files again.
+func (watcher *inotifyWatcher) run() {
+	if !watcher.sendExisting() {
+		return
+	}
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+
+	for {
+		n, err := watcher.file.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				logger.Error.Printf("Can't read inotify events: %s\n", err.Error())
+			}
+
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			mask := binary.NativeEndian.Uint32(buf[offset+4:])
+			length := int(binary.NativeEndian.Uint32(buf[offset+12:]))
+			name := string(bytes.TrimRight(buf[offset+unix.SizeofInotifyEvent:offset+unix.SizeofInotifyEvent+length], "\x00"))
+
+			offset += unix.SizeofInotifyEvent + length
+
+			switch {
+			case mask&unix.IN_Q_OVERFLOW != 0:
+				logger.Warning.Printf("Inotify queue overflow, rescanning %s\n", watcher.dir)
+
+				if !watcher.sendExisting() {
+					return
+				}
+			case mask&inotifyMask != 0 && name != "":
+				if !watcher.send(name) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (watcher *inotifyWatcher) sendExisting() bool {
+	entries, err := os.ReadDir(watcher.dir)
+	if err != nil {
+		logger.Error.Printf("Can't scan %s: %s\n", watcher.dir, err.Error())
+
+		return true
+	}
+
+	var files []os.FileInfo
+
+	for _, entry := range entries {
+		if fi, err := entry.Info(); err == nil && fi.Mode().IsRegular() {
+			files = append(files, fi)
+		}
+	}
+
+	for _, name := range sortedByModTime(files) {
+		if !watcher.send(name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (watcher *inotifyWatcher) send(name string) bool {
+	select {
+	case watcher.events <- name:
+		return true
+	case <-watcher.quit:
+		return false
+	}
+}
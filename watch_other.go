@@ -0,0 +1,10 @@
+//go:build !linux
+
+package main
+
+import "time"
+
+// newDropWatcher - the polling watcher, inotify is linux only.
+func newDropWatcher(dir string, interval time.Duration) (dropWatcher, error) {
+	return newPollWatcher(dir, interval)
+}
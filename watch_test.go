@@ -0,0 +1,149 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDumpWatch(t *testing.T) {
+	CurrentDump = NewDump()
+	DumpStatus = &dumpStatus{}
+
+	dropDir, dir := t.TempDir(), t.TempDir()
+
+	// the archive before the start and the rsync temporary file.
+	if err := os.WriteFile(filepath.Join(dropDir, "dump-1.zip"), zipDump(t, "dump.xml", xml01), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dropDir, ".dump-2.zip.XXXX"), zipDump(t, "dump.xml", xml01), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	watch := func() (chan struct{}, chan struct{}) {
+		kill, done := make(chan struct{}), make(chan struct{})
+
+		watcher, err := newDropWatcher(dropDir, 10*time.Millisecond)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		go DumpWatch(done, kill, watcher, dropDir, dir, 10*time.Millisecond)
+
+		return kill, done
+	}
+
+	waitState := func(name string) watchedFile {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			state, err := LoadWatchState(filepath.Join(dir, watchStateFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if file, ok := state[name]; ok {
+				return file
+			}
+		}
+
+		t.Fatalf("Expected %s in the watch state", name)
+
+		return watchedFile{}
+	}
+
+	kill, done := watch()
+
+	if file := waitState("dump-1.zip"); file.Error != "" {
+		t.Errorf("Expected processed dump-1.zip, got %q", file.Error)
+	}
+
+	if err := os.WriteFile(filepath.Join(dropDir, "dump-3.zip"), []byte("not a zip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if file := waitState("dump-3.zip"); file.Error == "" {
+		t.Errorf("Expected error of dump-3.zip, got none")
+	}
+
+	// the late archive doesn't rewind the registry.
+	older := strings.Replace(xml01, `updateTime="2011-01-01T01:01:01+03:00"`, `updateTime="2010-01-01T01:01:01+03:00"`, 1)
+	if err := os.WriteFile(filepath.Join(dropDir, "dump-0.zip"), zipDump(t, "dump.xml", older), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if file := waitState("dump-0.zip"); file.Skipped == "" || file.Error != "" {
+		t.Errorf("Expected skipped dump-0.zip, got %+v", file)
+	}
+
+	close(kill)
+	<-done
+
+	if _, ok := CurrentDump.ContentIndex[444]; !ok {
+		t.Errorf("Expected records of dump-1.zip")
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "dump.zip")); err != nil {
+		t.Errorf("Expected the cached archive, got %v", err)
+	}
+
+	state, _ := LoadWatchState(filepath.Join(dir, watchStateFile))
+	if len(state) != 3 {
+		t.Errorf("Expected 3 processed archives, got %v", state)
+	}
+
+	// the restart doesn't process them again.
+	CurrentDump = NewDump()
+
+	kill, done = watch()
+	time.Sleep(200 * time.Millisecond)
+	close(kill)
+	<-done
+
+	if len(CurrentDump.ContentIndex) != 0 {
+		t.Errorf("Expected no reprocessed archives, got %d records", len(CurrentDump.ContentIndex))
+	}
+}
+
+func TestDropWatcherMissingDir(t *testing.T) {
+	if _, err := newDropWatcher(filepath.Join(t.TempDir(), "missing"), 10*time.Millisecond); err == nil {
+		t.Errorf("Expected error of the missing directory, got none")
+	}
+}
+
+func TestPollWatcher(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "a.zip"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := newPollWatcher(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer watcher.Close()
+
+	next := func() string {
+		select {
+		case name := <-watcher.Events():
+			return name
+		case <-time.After(5 * time.Second):
+			return ""
+		}
+	}
+
+	if name := next(); name != "a.zip" {
+		t.Errorf("Expected a.zip, got %q", name)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "b.zip"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if name := next(); name != "b.zip" {
+		t.Errorf("Expected b.zip, got %q", name)
+	}
+}